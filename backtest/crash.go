@@ -0,0 +1,168 @@
+package backtest
+
+import (
+	"math"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// CrashBet representa a decisão da estratégia para uma rodada; Amount 0 não aposta
+type CrashBet struct {
+	Amount  float64 `json:"amount"`
+	CashOut float64 `json:"cash_out"` // multiplicador do auto cashout
+}
+
+// CrashState representa o que a estratégia conhece antes da rodada.
+// Os slices são compartilhados com o backtest e não devem ser alterados.
+type CrashState struct {
+	History  []blazego.CrashResult
+	Ledger   []CrashEntry
+	Bankroll float64
+}
+
+// CrashStrategy decide a aposta e o auto cashout de cada rodada do crash
+type CrashStrategy interface {
+	Decide(state CrashState) CrashBet
+}
+
+// CrashStrategyFunc permite usar uma função como CrashStrategy
+type CrashStrategyFunc func(state CrashState) CrashBet
+
+func (f CrashStrategyFunc) Decide(state CrashState) CrashBet {
+	return f(state)
+}
+
+// CrashEntry representa uma linha do extrato do backtest
+type CrashEntry struct {
+	Round      int     `json:"round"`
+	ID         string  `json:"id"`
+	CrashPoint float64 `json:"crash_point"`
+	Amount     float64 `json:"amount"`
+	CashOut    float64 `json:"cash_out"`
+	Won        bool    `json:"won"`
+	Profit     float64 `json:"profit"`
+	Bankroll   float64 `json:"bankroll"`
+}
+
+// CrashReport representa o resultado do backtest do crash
+type CrashReport struct {
+	Summary
+	Ledger []CrashEntry `json:"ledger"`
+	// Equity é a banca depois de cada rodada (curva de P&L)
+	Equity []float64 `json:"equity"`
+}
+
+// RunCrash executa a estratégia sobre as rodadas em ordem cronológica
+func RunCrash(results []blazego.CrashResult, strategy CrashStrategy, options Options) CrashReport {
+	report := runCrash(results, strategy, options)
+
+	report.RuinProbability = ruinProbability(len(results), options, func(indexes []int) bool {
+		sample := make([]blazego.CrashResult, len(indexes))
+		for i, index := range indexes {
+			sample[i] = results[index]
+		}
+		return runCrash(sample, strategy, options).Ruined
+	})
+
+	return report
+}
+
+func runCrash(results []blazego.CrashResult, strategy CrashStrategy, options Options) CrashReport {
+	builder := newSummaryBuilder(options.Bankroll)
+	report := CrashReport{
+		Ledger: []CrashEntry{},
+		Equity: []float64{},
+	}
+
+	for i, result := range results {
+		bankroll := builder.summary.FinalBankroll
+		if bankroll <= options.RuinThreshold {
+			builder.summary.Ruined = true
+			break
+		}
+
+		bet := validCrashBet(strategy.Decide(CrashState{
+			History:  results[:i],
+			Ledger:   report.Ledger,
+			Bankroll: bankroll,
+		}), bankroll)
+
+		entry := settleCrash(i, result, bet)
+		builder.add(entry.Amount, entry.Profit)
+		entry.Bankroll = builder.summary.FinalBankroll
+
+		report.Ledger = append(report.Ledger, entry)
+		report.Equity = append(report.Equity, entry.Bankroll)
+	}
+
+	if builder.summary.FinalBankroll <= options.RuinThreshold {
+		builder.summary.Ruined = true
+	}
+
+	report.Summary = builder.build()
+	return report
+}
+
+// validCrashBet limita a aposta à banca e descarta apostas sem auto cashout válido
+func validCrashBet(bet CrashBet, bankroll float64) CrashBet {
+	bet.Amount = math.Min(math.Max(bet.Amount, 0), bankroll)
+	if bet.Amount == 0 || bet.CashOut <= 1 {
+		return CrashBet{}
+	}
+	return bet
+}
+
+// settleCrash calcula o resultado da aposta; Bankroll fica a cargo de quem chama
+func settleCrash(round int, result blazego.CrashResult, bet CrashBet) CrashEntry {
+	entry := CrashEntry{
+		Round:      round,
+		ID:         result.ID,
+		CrashPoint: result.CrashPoint,
+	}
+
+	if bet.Amount > 0 {
+		entry.Amount = bet.Amount
+		entry.CashOut = bet.CashOut
+		entry.Won = bet.CashOut <= result.CrashPoint
+		if entry.Won {
+			entry.Profit = bet.Amount * (bet.CashOut - 1)
+		} else {
+			entry.Profit = -bet.Amount
+		}
+	}
+
+	return entry
+}
+
+// AutoCashout aposta sempre o mesmo valor com o mesmo auto cashout
+type AutoCashout struct {
+	Amount float64
+	Target float64
+}
+
+func (s AutoCashout) Decide(state CrashState) CrashBet {
+	return CrashBet{Amount: s.Amount, CashOut: s.Target}
+}
+
+// CrashMartingale dobra a aposta depois de cada perda e volta ao valor base depois de um ganho
+type CrashMartingale struct {
+	Base     float64
+	Target   float64
+	MaxStake float64 // limite da aposta (0 sem limite)
+}
+
+func (s CrashMartingale) Decide(state CrashState) CrashBet {
+	amount := s.Base
+	if len(state.Ledger) > 0 {
+		last := state.Ledger[len(state.Ledger)-1]
+		if last.Amount > 0 && !last.Won {
+			amount = last.Amount * 2
+		}
+	}
+
+	if s.MaxStake > 0 && amount > s.MaxStake {
+		amount = s.Base
+	}
+
+	return CrashBet{Amount: amount, CashOut: s.Target}
+}
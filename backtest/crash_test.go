@@ -0,0 +1,135 @@
+package backtest
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func crashResults(points ...float64) []blazego.CrashResult {
+	results := make([]blazego.CrashResult, len(points))
+	for i, point := range points {
+		results[i] = blazego.CrashResult{Game: "crash", ID: "r" + strconv.Itoa(i), CrashPoint: point}
+	}
+	return results
+}
+
+func crashStakes(ledger []CrashEntry) []float64 {
+	stakes := make([]float64, len(ledger))
+	for i, entry := range ledger {
+		stakes[i] = entry.Amount
+	}
+	return stakes
+}
+
+func TestRunCrash(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   []float64
+		strategy CrashStrategy
+		options  Options
+		stakes   []float64
+		want     Summary
+	}{
+		{
+			"auto cashout",
+			[]float64{1.5, 3, 1.2, 2, 5},
+			AutoCashout{Amount: 10, Target: 2},
+			Options{Bankroll: 100},
+			[]float64{10, 10, 10, 10, 10},
+			Summary{Rounds: 5, Bets: 5, Wins: 3, HitRate: 0.6, StartBankroll: 100, FinalBankroll: 110, Profit: 10, Wagered: 50, MaxDrawdown: 10, MaxDrawdownPercent: 10},
+		},
+		{
+			"martingale",
+			[]float64{1.5, 1.2, 3, 1.1, 2.5},
+			CrashMartingale{Base: 10, Target: 2},
+			Options{Bankroll: 100},
+			[]float64{10, 20, 40, 10, 20},
+			Summary{Rounds: 5, Bets: 5, Wins: 2, HitRate: 0.4, StartBankroll: 100, FinalBankroll: 120, Profit: 20, Wagered: 100, MaxDrawdown: 30, MaxDrawdownPercent: 30},
+		},
+		{
+			"martingale max stake",
+			[]float64{1.5, 1.2, 3, 1.1, 2.5},
+			CrashMartingale{Base: 10, Target: 2, MaxStake: 30},
+			Options{Bankroll: 100},
+			[]float64{10, 20, 10, 10, 20},
+			Summary{Rounds: 5, Bets: 5, Wins: 2, HitRate: 0.4, StartBankroll: 100, FinalBankroll: 90, Profit: -10, Wagered: 70, MaxDrawdown: 30, MaxDrawdownPercent: 30},
+		},
+		{
+			"stake limited to the bankroll until ruin",
+			[]float64{1.5, 1.5, 1.5, 3},
+			AutoCashout{Amount: 10, Target: 2},
+			Options{Bankroll: 25},
+			[]float64{10, 10, 5},
+			Summary{Rounds: 3, Bets: 3, StartBankroll: 25, FinalBankroll: 0, Profit: -25, Wagered: 25, MaxDrawdown: 25, MaxDrawdownPercent: 100, Ruined: true},
+		},
+		{
+			"ruin threshold",
+			[]float64{1.5, 1.5, 1.5},
+			AutoCashout{Amount: 10, Target: 2},
+			Options{Bankroll: 100, RuinThreshold: 85},
+			[]float64{10, 10},
+			Summary{Rounds: 2, Bets: 2, StartBankroll: 100, FinalBankroll: 80, Profit: -20, Wagered: 20, MaxDrawdown: 20, MaxDrawdownPercent: 20, Ruined: true},
+		},
+		{
+			"invalid cashout skips the round",
+			[]float64{1.5, 3},
+			AutoCashout{Amount: 10, Target: 1},
+			Options{Bankroll: 100},
+			[]float64{0, 0},
+			Summary{Rounds: 2, StartBankroll: 100, FinalBankroll: 100},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := RunCrash(crashResults(test.points...), test.strategy, test.options)
+
+			if stakes := crashStakes(report.Ledger); !slices.Equal(stakes, test.stakes) {
+				t.Errorf("stakes = %v, want %v", stakes, test.stakes)
+			}
+			if report.Summary != test.want {
+				t.Errorf("summary = %+v\nwant      %+v", report.Summary, test.want)
+			}
+			if len(report.Equity) != len(report.Ledger) || report.Equity[len(report.Equity)-1] != test.want.FinalBankroll {
+				t.Errorf("equity = %v", report.Equity)
+			}
+		})
+	}
+}
+
+func TestRunCrashRuinProbability(t *testing.T) {
+	strategy := AutoCashout{Amount: 10, Target: 2}
+
+	tests := []struct {
+		name   string
+		points []float64
+		want   float64
+	}{
+		{"always losing", []float64{1.5, 1.5}, 1},
+		{"always winning", []float64{3, 3}, 0},
+		// a banca de 20 só quebra quando as duas rodadas sorteadas são perdas: 1/4
+		{"half losing", []float64{1.5, 3}, 0.25},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := Options{Bankroll: 20, Simulations: 4000, Seed: 42}
+			report := RunCrash(crashResults(test.points...), strategy, options)
+
+			if math.Abs(report.RuinProbability-test.want) > 0.03 {
+				t.Errorf("ruin probability = %v, want %v", report.RuinProbability, test.want)
+			}
+			if again := RunCrash(crashResults(test.points...), strategy, options); again.RuinProbability != report.RuinProbability {
+				t.Errorf("same seed gave %v and %v", report.RuinProbability, again.RuinProbability)
+			}
+		})
+	}
+
+	if report := RunCrash(crashResults(1.5, 3), strategy, Options{Bankroll: 20}); report.RuinProbability != 0 {
+		t.Errorf("ruin probability without simulations = %v", report.RuinProbability)
+	}
+}
@@ -0,0 +1,250 @@
+package backtest
+
+import (
+	"github.com/viniciusgdr/blazego"
+)
+
+// DoubleBet representa uma aposta em uma cor do double
+type DoubleBet struct {
+	Color  blazego.DoubleColor `json:"color"`
+	Amount float64             `json:"amount"`
+}
+
+// DoubleState representa o que a estratégia conhece antes da rodada.
+// Os slices são compartilhados com o backtest e não devem ser alterados.
+type DoubleState struct {
+	History  []blazego.DoubleRound
+	Ledger   []DoubleEntry
+	Bankroll float64
+}
+
+// DoubleStrategy decide as cores e valores apostados em cada rodada do double; nenhuma aposta pula a rodada
+type DoubleStrategy interface {
+	Decide(state DoubleState) []DoubleBet
+}
+
+// DoubleStrategyFunc permite usar uma função como DoubleStrategy
+type DoubleStrategyFunc func(state DoubleState) []DoubleBet
+
+func (f DoubleStrategyFunc) Decide(state DoubleState) []DoubleBet {
+	return f(state)
+}
+
+// DoubleEntry representa uma linha do extrato do backtest
+type DoubleEntry struct {
+	Round    int                 `json:"round"`
+	ID       string              `json:"id"`
+	Roll     int                 `json:"roll"`
+	Color    blazego.DoubleColor `json:"color"`
+	Bets     []DoubleBet         `json:"bets"`
+	Stake    float64             `json:"stake"`
+	Payout   float64             `json:"payout"`
+	Profit   float64             `json:"profit"`
+	Bankroll float64             `json:"bankroll"`
+}
+
+// DoubleReport representa o resultado do backtest do double
+type DoubleReport struct {
+	Summary
+	Ledger []DoubleEntry `json:"ledger"`
+	// Equity é a banca depois de cada rodada (curva de P&L)
+	Equity []float64 `json:"equity"`
+}
+
+// RunDouble executa a estratégia sobre as rodadas em ordem cronológica
+func RunDouble(rounds []blazego.DoubleRound, strategy DoubleStrategy, options Options) DoubleReport {
+	report := runDouble(rounds, strategy, options)
+
+	report.RuinProbability = ruinProbability(len(rounds), options, func(indexes []int) bool {
+		sample := make([]blazego.DoubleRound, len(indexes))
+		for i, index := range indexes {
+			sample[i] = rounds[index]
+		}
+		return runDouble(sample, strategy, options).Ruined
+	})
+
+	return report
+}
+
+func runDouble(rounds []blazego.DoubleRound, strategy DoubleStrategy, options Options) DoubleReport {
+	builder := newSummaryBuilder(options.Bankroll)
+	report := DoubleReport{
+		Ledger: []DoubleEntry{},
+		Equity: []float64{},
+	}
+
+	for i, round := range rounds {
+		bankroll := builder.summary.FinalBankroll
+		if bankroll <= options.RuinThreshold {
+			builder.summary.Ruined = true
+			break
+		}
+
+		bets := validDoubleBets(strategy.Decide(DoubleState{
+			History:  rounds[:i],
+			Ledger:   report.Ledger,
+			Bankroll: bankroll,
+		}), bankroll)
+
+		entry := settleDouble(i, round, bets)
+		builder.add(entry.Stake, entry.Profit)
+		entry.Bankroll = builder.summary.FinalBankroll
+
+		report.Ledger = append(report.Ledger, entry)
+		report.Equity = append(report.Equity, entry.Bankroll)
+	}
+
+	if builder.summary.FinalBankroll <= options.RuinThreshold {
+		builder.summary.Ruined = true
+	}
+
+	report.Summary = builder.build()
+	return report
+}
+
+// settleDouble calcula o resultado das apostas; Bankroll fica a cargo de quem chama
+func settleDouble(index int, round blazego.DoubleRound, bets []DoubleBet) DoubleEntry {
+	entry := DoubleEntry{
+		Round: index,
+		ID:    round.ID,
+		Roll:  round.Roll,
+		Color: round.Color,
+		Bets:  bets,
+	}
+
+	for _, bet := range bets {
+		entry.Stake += bet.Amount
+		if bet.Color == round.Color {
+			entry.Payout += bet.Amount * bet.Color.Payout()
+		}
+	}
+	entry.Profit = entry.Payout - entry.Stake
+
+	return entry
+}
+
+// validDoubleBets descarta apostas sem valor e reduz proporcionalmente as apostas que excedem a banca
+func validDoubleBets(bets []DoubleBet, bankroll float64) []DoubleBet {
+	valid := []DoubleBet{}
+	total := 0.0
+	for _, bet := range bets {
+		if bet.Amount > 0 {
+			valid = append(valid, bet)
+			total += bet.Amount
+		}
+	}
+
+	if total > bankroll {
+		for i := range valid {
+			valid[i].Amount = valid[i].Amount * bankroll / total
+		}
+	}
+
+	return valid
+}
+
+func lastDoubleStake(ledger []DoubleEntry) (DoubleEntry, bool) {
+	for i := len(ledger) - 1; i >= 0; i-- {
+		if ledger[i].Stake > 0 {
+			return ledger[i], true
+		}
+	}
+	return DoubleEntry{}, false
+}
+
+// FlatBet aposta sempre o mesmo valor na mesma cor
+type FlatBet struct {
+	Color  blazego.DoubleColor
+	Amount float64
+}
+
+func (s FlatBet) Decide(state DoubleState) []DoubleBet {
+	return []DoubleBet{{Color: s.Color, Amount: s.Amount}}
+}
+
+// Martingale dobra a aposta na cor depois de cada perda e volta ao valor base depois de um ganho
+type Martingale struct {
+	Color    blazego.DoubleColor
+	Base     float64
+	MaxStake float64 // limite da aposta, ao ser excedido volta ao valor base (0 sem limite)
+}
+
+func (s Martingale) Decide(state DoubleState) []DoubleBet {
+	amount := s.Base
+	if last, ok := lastDoubleStake(state.Ledger); ok && last.Profit < 0 {
+		amount = last.Stake * 2
+	}
+
+	if s.MaxStake > 0 && amount > s.MaxStake {
+		amount = s.Base
+	}
+
+	return []DoubleBet{{Color: s.Color, Amount: amount}}
+}
+
+// DAlembert aumenta a aposta em uma unidade depois de cada perda e diminui depois de cada ganho
+type DAlembert struct {
+	Color blazego.DoubleColor
+	Base  float64
+	Unit  float64
+}
+
+func (s DAlembert) Decide(state DoubleState) []DoubleBet {
+	amount := s.Base
+	if last, ok := lastDoubleStake(state.Ledger); ok {
+		amount = last.Stake
+		if last.Profit < 0 {
+			amount += s.Unit
+		} else {
+			amount -= s.Unit
+		}
+	}
+
+	if amount < s.Base {
+		amount = s.Base
+	}
+
+	return []DoubleBet{{Color: s.Color, Amount: amount}}
+}
+
+// WhiteGap aposta no branco quando a quantidade de rodadas sem branco atinge Gap
+type WhiteGap struct {
+	Gap    int
+	Amount float64
+}
+
+func (s WhiteGap) Decide(state DoubleState) []DoubleBet {
+	sinceWhite := 0
+	for i := len(state.History) - 1; i >= 0; i-- {
+		if state.History[i].Color == blazego.DoubleColorWhite {
+			break
+		}
+		sinceWhite++
+	}
+
+	if sinceWhite < s.Gap {
+		return nil
+	}
+
+	return []DoubleBet{{Color: blazego.DoubleColorWhite, Amount: s.Amount}}
+}
+
+// ReferenceDoubleStrategies retorna as estratégias de referência no vermelho (e no branco para
+// WhiteGap) com o valor base informado
+func ReferenceDoubleStrategies(amount float64) map[string]DoubleStrategy {
+	return map[string]DoubleStrategy{
+		"flat":       FlatBet{Color: blazego.DoubleColorRed, Amount: amount},
+		"martingale": Martingale{Color: blazego.DoubleColorRed, Base: amount},
+		"dalembert":  DAlembert{Color: blazego.DoubleColorRed, Base: amount, Unit: amount},
+		"white-gap":  WhiteGap{Gap: 20, Amount: amount},
+	}
+}
+
+// CompareDouble executa cada estratégia sobre as mesmas rodadas e retorna o resumo por nome
+func CompareDouble(rounds []blazego.DoubleRound, strategies map[string]DoubleStrategy, options Options) map[string]Summary {
+	summaries := make(map[string]Summary, len(strategies))
+	for name, strategy := range strategies {
+		summaries[name] = RunDouble(rounds, strategy, options).Summary
+	}
+	return summaries
+}
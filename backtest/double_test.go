@@ -0,0 +1,119 @@
+package backtest
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// doubleRolls são preto, preto, vermelho, preto, branco e vermelho
+var doubleRolls = []int{8, 9, 3, 10, 0, 2}
+
+func doubleRounds(rolls ...int) []blazego.DoubleRound {
+	rounds := make([]blazego.DoubleRound, len(rolls))
+	for i, roll := range rolls {
+		color, _ := blazego.DoubleColorFromRoll(roll)
+		rounds[i] = blazego.DoubleRound{ID: "d" + strconv.Itoa(i), Status: blazego.DoubleStatusComplete, Roll: roll, Color: color}
+	}
+	return rounds
+}
+
+func TestRunDoubleStrategies(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy DoubleStrategy
+		stakes   []float64
+		profits  []float64
+	}{
+		{"flat", FlatBet{Color: blazego.DoubleColorRed, Amount: 10},
+			[]float64{10, 10, 10, 10, 10, 10}, []float64{-10, -10, 10, -10, -10, 10}},
+		{"martingale", Martingale{Color: blazego.DoubleColorRed, Base: 10},
+			[]float64{10, 20, 40, 10, 20, 40}, []float64{-10, -20, 40, -10, -20, 40}},
+		{"martingale max stake", Martingale{Color: blazego.DoubleColorRed, Base: 10, MaxStake: 30},
+			[]float64{10, 20, 10, 10, 20, 10}, []float64{-10, -20, 10, -10, -20, 10}},
+		{"dalembert", DAlembert{Color: blazego.DoubleColorRed, Base: 10, Unit: 5},
+			[]float64{10, 15, 20, 15, 20, 25}, []float64{-10, -15, 20, -15, -20, 25}},
+		{"white gap", WhiteGap{Gap: 2, Amount: 5},
+			[]float64{0, 0, 5, 5, 5, 0}, []float64{0, 0, -5, -5, 65, 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := RunDouble(doubleRounds(doubleRolls...), test.strategy, Options{Bankroll: 1000})
+
+			stakes := []float64{}
+			profits := []float64{}
+			bankroll := 1000.0
+			for i, entry := range report.Ledger {
+				stakes = append(stakes, entry.Stake)
+				profits = append(profits, entry.Profit)
+
+				bankroll += entry.Profit
+				if entry.Round != i || entry.ID != "d"+strconv.Itoa(i) || entry.Roll != doubleRolls[i] || entry.Bankroll != bankroll || report.Equity[i] != bankroll {
+					t.Errorf("entry %d = %+v, equity %v", i, entry, report.Equity[i])
+				}
+				if entry.Payout != entry.Stake+entry.Profit {
+					t.Errorf("entry %d payout = %v", i, entry.Payout)
+				}
+			}
+
+			if !slices.Equal(stakes, test.stakes) {
+				t.Errorf("stakes = %v, want %v", stakes, test.stakes)
+			}
+			if !slices.Equal(profits, test.profits) {
+				t.Errorf("profits = %v, want %v", profits, test.profits)
+			}
+			if report.FinalBankroll != bankroll {
+				t.Errorf("final bankroll = %v, want %v", report.FinalBankroll, bankroll)
+			}
+		})
+	}
+}
+
+func TestRunDoubleSummary(t *testing.T) {
+	report := RunDouble(doubleRounds(doubleRolls...), Martingale{Color: blazego.DoubleColorRed, Base: 10}, Options{Bankroll: 1000})
+
+	want := Summary{Rounds: 6, Bets: 6, Wins: 2, HitRate: 2.0 / 6, StartBankroll: 1000, FinalBankroll: 1020, Profit: 20, Wagered: 140, MaxDrawdown: 30, MaxDrawdownPercent: 3}
+	if report.Summary != want {
+		t.Errorf("summary = %+v\nwant      %+v", report.Summary, want)
+	}
+}
+
+func TestRunDoubleBetsAboveBankroll(t *testing.T) {
+	strategy := DoubleStrategyFunc(func(state DoubleState) []DoubleBet {
+		return []DoubleBet{{Color: blazego.DoubleColorRed, Amount: 60}, {Color: blazego.DoubleColorBlack, Amount: 60}, {Color: blazego.DoubleColorWhite}}
+	})
+
+	report := RunDouble(doubleRounds(3), strategy, Options{Bankroll: 100})
+
+	entry := report.Ledger[0]
+	if len(entry.Bets) != 2 || entry.Bets[0].Amount != 50 || entry.Bets[1].Amount != 50 {
+		t.Errorf("bets = %+v, want 50 on red and black", entry.Bets)
+	}
+	if entry.Stake != 100 || entry.Payout != 100 || entry.Profit != 0 {
+		t.Errorf("entry = %+v", entry)
+	}
+}
+
+func TestCompareDouble(t *testing.T) {
+	rounds := doubleRounds(doubleRolls...)
+	options := Options{Bankroll: 1000}
+	strategies := ReferenceDoubleStrategies(10)
+
+	summaries := CompareDouble(rounds, strategies, options)
+	if len(summaries) != len(strategies) {
+		t.Fatalf("got %d summaries, want %d", len(summaries), len(strategies))
+	}
+	for name, strategy := range strategies {
+		if want := RunDouble(rounds, strategy, options).Summary; summaries[name] != want {
+			t.Errorf("%s = %+v, want %+v", name, summaries[name], want)
+		}
+	}
+
+	// o white-gap de referência espera 20 rodadas sem branco
+	if summaries["white-gap"].Bets != 0 || summaries["martingale"].Profit != 20 {
+		t.Errorf("summaries = %+v", summaries)
+	}
+}
@@ -0,0 +1,103 @@
+// Package backtest avalia estratégias de aposta sobre rodadas já finalizadas, vindas do
+// histórico em memória, de uma sessão gravada ou do simulador.
+package backtest
+
+import "math/rand"
+
+// Summary representa as métricas de uma execução do backtest
+type Summary struct {
+	Rounds        int     `json:"rounds"`
+	Bets          int     `json:"bets"`
+	Wins          int     `json:"wins"`
+	HitRate       float64 `json:"hit_rate"`
+	StartBankroll float64 `json:"start_bankroll"`
+	FinalBankroll float64 `json:"final_bankroll"`
+	Profit        float64 `json:"profit"`
+	Wagered       float64 `json:"wagered"`
+	// MaxDrawdown é a maior queda da banca em relação ao pico anterior
+	MaxDrawdown        float64 `json:"max_drawdown"`
+	MaxDrawdownPercent float64 `json:"max_drawdown_percent"`
+	Ruined             bool    `json:"ruined"`
+	// RuinProbability é a fração das simulações de Monte Carlo que terminaram em ruína
+	RuinProbability float64 `json:"ruin_probability"`
+}
+
+// Options configura uma execução do backtest
+type Options struct {
+	Bankroll float64
+	// RuinThreshold é o valor da banca considerado ruína (padrão 0)
+	RuinThreshold float64
+	// Simulations é a quantidade de reamostragens das rodadas usadas para estimar a
+	// probabilidade de ruína (0 desabilita)
+	Simulations int
+	Seed        int64
+}
+
+type summaryBuilder struct {
+	summary Summary
+	peak    float64
+}
+
+func newSummaryBuilder(bankroll float64) *summaryBuilder {
+	return &summaryBuilder{
+		summary: Summary{
+			StartBankroll: bankroll,
+			FinalBankroll: bankroll,
+		},
+		peak: bankroll,
+	}
+}
+
+func (b *summaryBuilder) add(stake, profit float64) {
+	b.summary.Rounds++
+	if stake > 0 {
+		b.summary.Bets++
+		b.summary.Wagered += stake
+		if profit > 0 {
+			b.summary.Wins++
+		}
+	}
+
+	b.summary.FinalBankroll += profit
+	if b.summary.FinalBankroll > b.peak {
+		b.peak = b.summary.FinalBankroll
+	}
+
+	if drawdown := b.peak - b.summary.FinalBankroll; drawdown > b.summary.MaxDrawdown {
+		b.summary.MaxDrawdown = drawdown
+		if b.peak > 0 {
+			b.summary.MaxDrawdownPercent = drawdown / b.peak * 100
+		}
+	}
+}
+
+func (b *summaryBuilder) build() Summary {
+	summary := b.summary
+	summary.Profit = summary.FinalBankroll - summary.StartBankroll
+	if summary.Bets > 0 {
+		summary.HitRate = float64(summary.Wins) / float64(summary.Bets)
+	}
+	return summary
+}
+
+// ruinProbability executa run sobre reamostragens (com reposição) dos índices das rodadas
+func ruinProbability(rounds int, options Options, run func(indexes []int) bool) float64 {
+	if options.Simulations <= 0 || rounds == 0 {
+		return 0
+	}
+
+	random := rand.New(rand.NewSource(options.Seed))
+	indexes := make([]int, rounds)
+	ruins := 0
+
+	for range options.Simulations {
+		for i := range indexes {
+			indexes[i] = random.Intn(rounds)
+		}
+		if run(indexes) {
+			ruins++
+		}
+	}
+
+	return float64(ruins) / float64(options.Simulations)
+}
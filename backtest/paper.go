@@ -0,0 +1,488 @@
+package backtest
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/internal/emitter"
+)
+
+// PaperOptions configura a execução de uma estratégia contra o feed ao vivo sem apostas reais
+type PaperOptions struct {
+	Bankroll float64
+	// LedgerPath grava as decisões, resultados e liquidações em JSON Lines; se o arquivo existir, a
+	// banca inicial, o extrato e o histórico de resultados são restaurados a partir dele
+	LedgerPath string
+	// HistorySize é a quantidade de resultados passados entregues à estratégia (padrão 500)
+	HistorySize int
+}
+
+// PaperRecord representa uma linha do extrato persistido pelo paper trading
+type PaperRecord struct {
+	Kind        string               `json:"kind"` // "decision", "result" ou "settlement"
+	Time        time.Time            `json:"time"`
+	RoundID     string               `json:"round_id"`
+	Crash       *CrashBet            `json:"crash,omitempty"`
+	Double      []DoubleBet          `json:"double,omitempty"`
+	CrashResult *blazego.CrashResult `json:"crash_result,omitempty"`
+	DoubleRound *blazego.DoubleRound `json:"double_round,omitempty"`
+	CrashEntry  *CrashEntry          `json:"crash_entry,omitempty"`
+	DoubleEntry *DoubleEntry         `json:"double_entry,omitempty"`
+}
+
+// CrashPaperTrader executa uma CrashStrategy sobre os eventos crash.tick ao vivo.
+// A decisão é tomada no status "waiting" (antes do "graphing") e liquidada no "complete".
+// Eventos emitidos: "decision" (PaperRecord), "settled" (CrashEntry) e "error" (error).
+type CrashPaperTrader struct {
+	mu        sync.Mutex
+	strategy  CrashStrategy
+	options   PaperOptions
+	file      *paperLedger
+	events    *emitter.Emitter
+	history   []blazego.CrashResult
+	ledger    []CrashEntry
+	start     float64
+	bankroll  float64
+	pendingID string
+	pending   CrashBet
+}
+
+func NewCrashPaperTrader(strategy CrashStrategy, options PaperOptions) (*CrashPaperTrader, error) {
+	if options.HistorySize <= 0 {
+		options.HistorySize = 500
+	}
+
+	file, records, err := openPaperLedger(options.LedgerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	trader := &CrashPaperTrader{
+		strategy: strategy,
+		options:  options,
+		file:     file,
+		ledger:   []CrashEntry{},
+		events:   emitter.New(),
+		start:    options.Bankroll,
+		bankroll: options.Bankroll,
+	}
+
+	for _, record := range records {
+		switch {
+		case record.Kind == "result" && record.CrashResult != nil:
+			trader.addResult(*record.CrashResult)
+
+		case record.Kind == "settlement" && record.CrashEntry != nil:
+			entry := *record.CrashEntry
+			if len(trader.ledger) == 0 {
+				// a banca inicial é a da primeira liquidação gravada, não a das opções atuais
+				trader.start = entry.Bankroll - entry.Profit
+			}
+			trader.ledger = append(trader.ledger, entry)
+			trader.bankroll = entry.Bankroll
+		}
+	}
+
+	return trader, nil
+}
+
+// Attach registra o trader nos eventos crash.tick da conexão
+func (p *CrashPaperTrader) Attach(conn blazego.ConnectionSocketResponses) {
+	conn.On("crash.tick", func(data interface{}) {
+		tickEvent, err := blazego.DecodeEvent[blazego.CrashTickEvent](data)
+		if err == nil {
+			err = p.Handle(tickEvent)
+		}
+
+		if err != nil {
+			p.events.Emit("error", err)
+		}
+	})
+}
+
+// Handle processa um tick do crash
+func (p *CrashPaperTrader) Handle(event blazego.CrashTickEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch event.Status {
+	case "waiting":
+		if p.pendingID == event.ID {
+			return nil
+		}
+
+		bet := validCrashBet(p.strategy.Decide(CrashState{
+			History:  p.history,
+			Ledger:   p.ledger,
+			Bankroll: p.bankroll,
+		}), p.bankroll)
+
+		p.pendingID = event.ID
+		p.pending = bet
+
+		record := PaperRecord{
+			Kind:    "decision",
+			Time:    time.Now(),
+			RoundID: event.ID,
+			Crash:   &bet,
+		}
+		p.events.Emit("decision", record)
+		return p.file.write(record)
+
+	case "complete":
+		if event.CrashPoint == nil {
+			return fmt.Errorf("missing crash point for round %s", event.ID)
+		}
+		if len(p.history) > 0 && p.history[len(p.history)-1].ID == event.ID {
+			return nil
+		}
+
+		result := blazego.CrashResult{
+			ID:           event.ID,
+			CrashPoint:   float64(*event.CrashPoint),
+			IsBonusRound: event.IsBonusRound,
+			UpdatedAt:    event.UpdatedAt,
+			CompletedAt:  time.Now(),
+		}
+		p.addResult(result)
+
+		err := p.file.write(PaperRecord{
+			Kind:        "result",
+			Time:        result.CompletedAt,
+			RoundID:     event.ID,
+			CrashResult: &result,
+		})
+		if err != nil || p.pendingID != event.ID {
+			return err
+		}
+		p.pendingID = ""
+
+		entry := settleCrash(len(p.ledger), result, p.pending)
+		p.bankroll += entry.Profit
+		entry.Bankroll = p.bankroll
+		p.ledger = append(p.ledger, entry)
+
+		p.events.Emit("settled", entry)
+		return p.file.write(PaperRecord{
+			Kind:       "settlement",
+			Time:       time.Now(),
+			RoundID:    event.ID,
+			CrashEntry: &entry,
+		})
+	}
+
+	return nil
+}
+
+// addResult acrescenta o resultado ao histórico entregue à estratégia; quem chama segura p.mu
+func (p *CrashPaperTrader) addResult(result blazego.CrashResult) {
+	p.history = append(p.history, result)
+	if len(p.history) > p.options.HistorySize {
+		p.history = p.history[len(p.history)-p.options.HistorySize:]
+	}
+}
+
+func (p *CrashPaperTrader) Bankroll() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.bankroll
+}
+
+// Ledger retorna uma cópia do extrato das rodadas liquidadas
+func (p *CrashPaperTrader) Ledger() []CrashEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ledger := make([]CrashEntry, len(p.ledger))
+	copy(ledger, p.ledger)
+	return ledger
+}
+
+// Summary calcula as métricas das rodadas liquidadas
+func (p *CrashPaperTrader) Summary() Summary {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	builder := newSummaryBuilder(p.start)
+	for _, entry := range p.ledger {
+		builder.add(entry.Amount, entry.Profit)
+	}
+	return builder.build()
+}
+
+func (p *CrashPaperTrader) On(event string, callback func(data interface{})) {
+	p.events.On(event, callback)
+}
+
+func (p *CrashPaperTrader) Close() error {
+	return p.file.close()
+}
+
+// DoublePaperTrader executa uma DoubleStrategy sobre os eventos double.tick ao vivo.
+// A decisão é tomada no status "waiting" (antes do "rolling") e liquidada no "complete".
+// Eventos emitidos: "decision" (PaperRecord), "settled" (DoubleEntry) e "error" (error).
+type DoublePaperTrader struct {
+	mu        sync.Mutex
+	strategy  DoubleStrategy
+	options   PaperOptions
+	file      *paperLedger
+	events    *emitter.Emitter
+	history   []blazego.DoubleRound
+	ledger    []DoubleEntry
+	start     float64
+	bankroll  float64
+	pendingID string
+	pending   []DoubleBet
+}
+
+func NewDoublePaperTrader(strategy DoubleStrategy, options PaperOptions) (*DoublePaperTrader, error) {
+	if options.HistorySize <= 0 {
+		options.HistorySize = 500
+	}
+
+	file, records, err := openPaperLedger(options.LedgerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	trader := &DoublePaperTrader{
+		strategy: strategy,
+		options:  options,
+		file:     file,
+		ledger:   []DoubleEntry{},
+		events:   emitter.New(),
+		start:    options.Bankroll,
+		bankroll: options.Bankroll,
+	}
+
+	for _, record := range records {
+		switch {
+		case record.Kind == "result" && record.DoubleRound != nil:
+			trader.addRound(*record.DoubleRound)
+
+		case record.Kind == "settlement" && record.DoubleEntry != nil:
+			entry := *record.DoubleEntry
+			if len(trader.ledger) == 0 {
+				trader.start = entry.Bankroll - entry.Profit
+			}
+			trader.ledger = append(trader.ledger, entry)
+			trader.bankroll = entry.Bankroll
+		}
+	}
+
+	return trader, nil
+}
+
+// Attach registra o trader nos eventos double.tick da conexão
+func (p *DoublePaperTrader) Attach(conn blazego.ConnectionSocketResponses) {
+	conn.On("double.tick", func(data interface{}) {
+		tickEvent, err := blazego.DecodeEvent[blazego.DoubleTickEvent](data)
+		if err == nil {
+			err = p.Handle(tickEvent)
+		}
+
+		if err != nil {
+			p.events.Emit("error", err)
+		}
+	})
+}
+
+// Handle processa um tick do double
+func (p *DoublePaperTrader) Handle(event blazego.DoubleTickEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch event.Status {
+	case blazego.DoubleStatusWaiting:
+		if p.pendingID == event.ID {
+			return nil
+		}
+
+		bets := validDoubleBets(p.strategy.Decide(DoubleState{
+			History:  p.history,
+			Ledger:   p.ledger,
+			Bankroll: p.bankroll,
+		}), p.bankroll)
+
+		p.pendingID = event.ID
+		p.pending = bets
+
+		record := PaperRecord{
+			Kind:    "decision",
+			Time:    time.Now(),
+			RoundID: event.ID,
+			Double:  bets,
+		}
+		p.events.Emit("decision", record)
+		return p.file.write(record)
+
+	case blazego.DoubleStatusComplete:
+		if len(p.history) > 0 && p.history[len(p.history)-1].ID == event.ID {
+			return nil
+		}
+
+		roll, color, err := event.Result()
+		if err != nil {
+			return err
+		}
+
+		round := blazego.DoubleRound{
+			ID:          event.ID,
+			Status:      event.Status,
+			Color:       color,
+			Roll:        roll,
+			CreatedAt:   event.CreatedAt,
+			UpdatedAt:   event.UpdatedAt,
+			CompletedAt: time.Now(),
+		}
+		p.addRound(round)
+
+		err = p.file.write(PaperRecord{
+			Kind:        "result",
+			Time:        round.CompletedAt,
+			RoundID:     event.ID,
+			DoubleRound: &round,
+		})
+		if err != nil || p.pendingID != event.ID {
+			return err
+		}
+		p.pendingID = ""
+
+		entry := settleDouble(len(p.ledger), round, p.pending)
+		p.bankroll += entry.Profit
+		entry.Bankroll = p.bankroll
+		p.ledger = append(p.ledger, entry)
+
+		p.events.Emit("settled", entry)
+		return p.file.write(PaperRecord{
+			Kind:        "settlement",
+			Time:        time.Now(),
+			RoundID:     event.ID,
+			DoubleEntry: &entry,
+		})
+	}
+
+	return nil
+}
+
+// addRound acrescenta a rodada ao histórico entregue à estratégia; quem chama segura p.mu
+func (p *DoublePaperTrader) addRound(round blazego.DoubleRound) {
+	p.history = append(p.history, round)
+	if len(p.history) > p.options.HistorySize {
+		p.history = p.history[len(p.history)-p.options.HistorySize:]
+	}
+}
+
+func (p *DoublePaperTrader) Bankroll() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.bankroll
+}
+
+// Ledger retorna uma cópia do extrato das rodadas liquidadas
+func (p *DoublePaperTrader) Ledger() []DoubleEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ledger := make([]DoubleEntry, len(p.ledger))
+	copy(ledger, p.ledger)
+	return ledger
+}
+
+// Summary calcula as métricas das rodadas liquidadas
+func (p *DoublePaperTrader) Summary() Summary {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	builder := newSummaryBuilder(p.start)
+	for _, entry := range p.ledger {
+		builder.add(entry.Stake, entry.Profit)
+	}
+	return builder.build()
+}
+
+func (p *DoublePaperTrader) On(event string, callback func(data interface{})) {
+	p.events.On(event, callback)
+}
+
+func (p *DoublePaperTrader) Close() error {
+	return p.file.close()
+}
+
+type paperLedger struct {
+	file *os.File
+}
+
+// openPaperLedger abre o extrato para escrita e retorna os registros já gravados.
+// Com path vazio retorna um extrato nil, que ignora as escritas.
+func openPaperLedger(path string) (*paperLedger, []PaperRecord, error) {
+	if path == "" {
+		return nil, nil, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records := []PaperRecord{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record PaperRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			file.Close()
+			return nil, nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+
+	return &paperLedger{file: file}, records, nil
+}
+
+func (l *paperLedger) write(record PaperRecord) error {
+	if l == nil {
+		return nil
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	_, err = l.file.Write(append(line, '\n'))
+	return err
+}
+
+func (l *paperLedger) close() error {
+	if l == nil {
+		return nil
+	}
+	if l.file == nil {
+		return errors.New("ledger closed")
+	}
+
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
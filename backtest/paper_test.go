@@ -0,0 +1,168 @@
+package backtest
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func crashTick(id, status string, point float64) blazego.CrashTickEvent {
+	event := blazego.CrashTickEvent{ID: id, Status: status}
+	if status == "complete" {
+		crashPoint := blazego.Float64String(point)
+		event.CrashPoint = &crashPoint
+	}
+	return event
+}
+
+func doubleTick(id, status string, roll int) blazego.DoubleTickEvent {
+	event := blazego.DoubleTickEvent{ID: id, Status: status}
+	if status == blazego.DoubleStatusComplete {
+		value := blazego.StringOrNumber(strconv.Itoa(roll))
+		event.Roll = &value
+	}
+	return event
+}
+
+func TestCrashPaperTraderRestore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "crash.jsonl")
+	points := []float64{3, 1.5, 2.5}
+
+	// a estratégia aposta apenas a partir da segunda rodada e registra o histórico recebido
+	var seen []int
+	strategy := CrashStrategyFunc(func(state CrashState) CrashBet {
+		seen = append(seen, len(state.History))
+		if len(state.History) == 0 {
+			return CrashBet{}
+		}
+		return CrashBet{Amount: 10, CashOut: 2}
+	})
+
+	trader, err := NewCrashPaperTrader(strategy, PaperOptions{Bankroll: 100, LedgerPath: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, point := range points {
+		id := strconv.Itoa(i)
+		for _, status := range []string{"waiting", "graphing", "complete"} {
+			if err := trader.Handle(crashTick(id, status, point)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := trader.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		bankroll float64
+	}{
+		{"same bankroll", 100},
+		{"different bankroll", 500},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			seen = nil
+			restored, err := NewCrashPaperTrader(strategy, PaperOptions{Bankroll: test.bankroll, LedgerPath: path})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer restored.Close()
+
+			summary := restored.Summary()
+			// três liquidações: sem aposta, perda de 10 e ganho de 10
+			if summary.Rounds != 3 || summary.StartBankroll != 100 || summary.FinalBankroll != 100 || summary.Profit != 0 {
+				t.Errorf("summary = %+v", summary)
+			}
+			if restored.Bankroll() != 100 {
+				t.Errorf("bankroll = %v, want 100", restored.Bankroll())
+			}
+
+			if err := restored.Handle(crashTick("next", "waiting", 0)); err != nil {
+				t.Fatal(err)
+			}
+			if len(seen) != 1 || seen[0] != len(points) {
+				t.Errorf("restored history sizes = %v, want [%d]", seen, len(points))
+			}
+		})
+	}
+}
+
+func TestDoublePaperTraderRestore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "double.jsonl")
+	strategy := DoubleStrategyFunc(func(state DoubleState) []DoubleBet {
+		return []DoubleBet{{Color: blazego.DoubleColorRed, Amount: 5}}
+	})
+
+	trader, err := NewDoublePaperTrader(strategy, PaperOptions{Bankroll: 50, LedgerPath: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// vermelho, preto e uma rodada acompanhada sem decisão
+	for i, roll := range []int{3, 10} {
+		id := strconv.Itoa(i)
+		for _, status := range []string{blazego.DoubleStatusWaiting, blazego.DoubleStatusRolling, blazego.DoubleStatusComplete} {
+			if err := trader.Handle(doubleTick(id, status, roll)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := trader.Handle(doubleTick("late", blazego.DoubleStatusComplete, 0)); err != nil {
+		t.Fatal(err)
+	}
+	trader.Close()
+
+	restored, err := NewDoublePaperTrader(strategy, PaperOptions{Bankroll: 1000, LedgerPath: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close()
+
+	summary := restored.Summary()
+	if summary.Rounds != 2 || summary.StartBankroll != 50 || summary.FinalBankroll != 50 || summary.Wagered != 10 {
+		t.Errorf("summary = %+v", summary)
+	}
+	if len(restored.history) != 3 || restored.history[2].ID != "late" {
+		t.Errorf("history = %+v", restored.history)
+	}
+}
+
+func TestPaperTraderEventOrder(t *testing.T) {
+	trader, err := NewCrashPaperTrader(AutoCashout{Amount: 1, Target: 2}, PaperOptions{Bankroll: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const rounds = 200
+	events := make(chan string, 2*rounds)
+	trader.On("decision", func(data interface{}) {
+		events <- "decision " + data.(PaperRecord).RoundID
+	})
+	trader.On("settled", func(data interface{}) {
+		events <- "settled " + data.(CrashEntry).ID
+	})
+
+	for i := range rounds {
+		id := strconv.Itoa(i)
+		trader.Handle(crashTick(id, "waiting", 0))
+		trader.Handle(crashTick(id, "complete", 1.5))
+	}
+
+	for i := range rounds {
+		for _, kind := range []string{"decision", "settled"} {
+			select {
+			case event := <-events:
+				if want := kind + " " + strconv.Itoa(i); event != want {
+					t.Fatalf("event = %s, want %s", event, want)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout")
+			}
+		}
+	}
+}
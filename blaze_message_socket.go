@@ -1,25 +1,43 @@
 package blazego
 
 import (
-	"encoding/json"
-	"regexp"
+	"fmt"
 	"time"
+
+	"github.com/viniciusgdr/blazego/internal/emitter"
 )
 
+// ChatRoom é a sala do Socket.IO inscrita pelo BlazeMessageSocket
+const ChatRoom = "chat_room_2"
+
 type BlazeMessageSocket struct {
-	socket    ConnectionSocket
-	callbacks map[string][]func(interface{})
-	interval  *time.Ticker
+	socket   ConnectionSocket
+	events   *emitter.Emitter
+	interval *time.Ticker
+	metrics  *connectionMetrics
+	// handlers indica se onMessage e initClose já foram registrados, como no BlazeSocket
+	handlers bool
 }
 
 func NewBlazeMessageSocket(socket ConnectionSocket) *BlazeMessageSocket {
 	return &BlazeMessageSocket{
-		socket:    socket,
-		callbacks: make(map[string][]func(interface{})),
+		socket: socket,
+		events: emitter.New(),
 	}
 }
 
 func (b *BlazeMessageSocket) Connect(options SocketOptions) error {
+	if b.metrics == nil && options.Metrics != nil {
+		b.metrics = newConnectionMetrics(options.Metrics, "chat")
+		b.events.Instrument(b.metrics.queueDepth, b.metrics.callbackLatency)
+	}
+
+	if !b.handlers {
+		b.onMessage()
+		b.initClose(options)
+		b.handlers = true
+	}
+
 	connectionOptions := ConnectionSocketOptions{
 		URL:     options.URL,
 		Options: options.Options,
@@ -35,58 +53,44 @@ func (b *BlazeMessageSocket) Connect(options SocketOptions) error {
 		timeoutPing = *options.TimeoutPing
 	}
 
+	b.metrics.opened()
 	b.initPing(timeoutPing)
 	b.initOpen(options.Token)
-	b.onMessage()
-	b.initClose(options)
 
 	return nil
 }
 
 func (b *BlazeMessageSocket) initPing(timeoutPing int) {
-	b.interval = time.NewTicker(time.Duration(timeoutPing) * time.Millisecond)
+	// a goroutine usa o próprio ticker, já que uma reconexão substitui b.interval
+	interval := time.NewTicker(time.Duration(timeoutPing) * time.Millisecond)
+	b.interval = interval
 
 	go func() {
-		for range b.interval.C {
-			b.socket.Send("2")
+		for range interval.C {
+			b.send("2")
 		}
 	}()
 }
 
 func (b *BlazeMessageSocket) onMessage() {
 	b.socket.On("message", func(data interface{}) {
-		var msg string
-		switch v := data.(type) {
-		case []byte:
-			msg = string(v)
-		case string:
-			msg = v
-		default:
-			return
-		}
-
-		regex := regexp.MustCompile(`^\d+\["data",\s*({.*})]$`)
-		matches := regex.FindStringSubmatch(msg)
-
-		if len(matches) < 2 {
-			return
-		}
-
-		var messageData struct {
-			Payload interface{} `json:"payload"`
-			ID      string      `json:"id"`
-		}
-
-		err := json.Unmarshal([]byte(matches[1]), &messageData)
-		if err != nil {
+		msg, ok := frameString(data)
+		if !ok {
+			b.metrics.parseFailure("frame_type")
 			return
 		}
+		b.metrics.frameReceived(msg)
 
-		if messageData.Payload == nil || messageData.ID == "" {
+		eventID, payload, ok := parseDataFrame(msg)
+		if !ok {
+			if isDataFrame(msg) {
+				b.metrics.parseFailure("frame")
+			}
 			return
 		}
 
-		b.emit(messageData.ID, messageData.Payload)
+		b.metrics.event(eventID)
+		b.emit(eventID, payload)
 	})
 }
 
@@ -97,6 +101,7 @@ func (b *BlazeMessageSocket) initClose(options SocketOptions) {
 		}
 
 		b.socket.Disconnect()
+		b.metrics.closed()
 
 		code, ok := data.(int)
 		if !ok {
@@ -125,30 +130,21 @@ func (b *BlazeMessageSocket) initClose(options SocketOptions) {
 }
 
 func (b *BlazeMessageSocket) initOpen(token *string) {
-	b.socket.On("open", func(data interface{}) {
-		subscriptions := []string{}
+	subscriptions := []string{}
 
-		subscribeMsg := `420["cmd",{"id":"subscribe","payload":{"room":"chat_room_2"}}]`
-		b.socket.Send(subscribeMsg)
-		subscriptions = append(subscriptions, "chat_room_2")
+	subscribeMsg := fmt.Sprintf(`420["cmd",{"id":"subscribe","payload":{"room":"%s"}}]`, ChatRoom)
+	b.send(subscribeMsg)
+	subscriptions = append(subscriptions, ChatRoom)
 
-		b.emit("subscriptions", subscriptions)
-	})
+	b.emit("subscriptions", subscriptions)
 }
 
 func (b *BlazeMessageSocket) On(event string, callback func(data interface{})) {
-	if b.callbacks[event] == nil {
-		b.callbacks[event] = make([]func(interface{}), 0)
-	}
-	b.callbacks[event] = append(b.callbacks[event], callback)
+	b.events.On(event, callback)
 }
 
 func (b *BlazeMessageSocket) emit(event string, data interface{}) {
-	if callbacks, exists := b.callbacks[event]; exists {
-		for _, callback := range callbacks {
-			go callback(data)
-		}
-	}
+	b.events.Emit(event, data)
 }
 
 func (b *BlazeMessageSocket) Emit(event string, data interface{}) {
@@ -156,6 +152,11 @@ func (b *BlazeMessageSocket) Emit(event string, data interface{}) {
 }
 
 func (b *BlazeMessageSocket) Send(data interface{}) error {
+	return b.send(data)
+}
+
+func (b *BlazeMessageSocket) send(data interface{}) error {
+	b.metrics.frameSent(data)
 	return b.socket.Send(data)
 }
 
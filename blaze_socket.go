@@ -1,25 +1,29 @@
 package blazego
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"regexp"
 	"time"
+
+	"github.com/viniciusgdr/blazego/internal/emitter"
 )
 
 type BlazeSocket struct {
 	socket                    ConnectionSocket
-	callbacks                 map[string][]func(interface{})
+	events                    *emitter.Emitter
 	cache                     map[string]interface{}
 	interval                  *time.Ticker
 	cacheIgnoreRepeatedEvents bool
+	metrics                   *connectionMetrics
+	// handlers indica se onMessage e initClose já foram registrados; o socket guarda os callbacks
+	// entre as reconexões e registrá-los de novo duplicaria os eventos
+	handlers bool
 }
 
 func NewBlazeSocket(socket ConnectionSocket, cacheIgnoreRepeatedEvents bool) *BlazeSocket {
 	blazeSocket := &BlazeSocket{
 		socket:                    socket,
-		callbacks:                 make(map[string][]func(interface{})),
+		events:                    emitter.New(),
 		cacheIgnoreRepeatedEvents: cacheIgnoreRepeatedEvents,
 	}
 
@@ -31,6 +35,23 @@ func NewBlazeSocket(socket ConnectionSocket, cacheIgnoreRepeatedEvents bool) *Bl
 }
 
 func (b *BlazeSocket) Connect(options SocketOptions) error {
+	socketType := "crash"
+	if options.Type != nil {
+		socketType = *options.Type
+	}
+
+	// as reconexões reaproveitam as métricas da primeira conexão
+	if b.metrics == nil && options.Metrics != nil {
+		b.metrics = newConnectionMetrics(options.Metrics, socketType)
+		b.events.Instrument(b.metrics.queueDepth, b.metrics.callbackLatency)
+	}
+
+	if !b.handlers {
+		b.onMessage()
+		b.initClose(options)
+		b.handlers = true
+	}
+
 	connectionOptions := ConnectionSocketOptions{
 		URL:     options.URL,
 		Options: options.Options,
@@ -46,91 +67,73 @@ func (b *BlazeSocket) Connect(options SocketOptions) error {
 		timeoutPing = *options.TimeoutPing
 	}
 
+	b.metrics.opened()
 	b.initPing(timeoutPing)
-
-	socketType := "crash"
-	if options.Type != nil {
-		socketType = *options.Type
-	}
-
 	b.initOpen(socketType, options.Token)
-	b.onMessage()
-	b.initClose(options)
 
 	return nil
 }
 
 func (b *BlazeSocket) initPing(timeoutPing int) {
-	b.interval = time.NewTicker(time.Duration(timeoutPing) * time.Millisecond)
+	// a goroutine usa o próprio ticker, já que uma reconexão substitui b.interval
+	interval := time.NewTicker(time.Duration(timeoutPing) * time.Millisecond)
+	b.interval = interval
 
 	go func() {
-		for range b.interval.C {
-			b.socket.Send("2")
+		for range interval.C {
+			b.send("2")
 		}
 	}()
 }
 
 func (b *BlazeSocket) onMessage() {
 	b.socket.On("message", func(data interface{}) {
-		var msg string
-		switch v := data.(type) {
-		case []byte:
-			msg = string(v)
-		case string:
-			msg = v
-		default:
-			return
-		}
-
-		regex := regexp.MustCompile(`^\d+\["data",\s*({.*})]$`)
-		matches := regex.FindStringSubmatch(msg)
-
-		if len(matches) < 2 {
-			return
-		}
-
-		var messageData struct {
-			Payload interface{} `json:"payload"`
-			ID      string      `json:"id"`
-		}
-
-		err := json.Unmarshal([]byte(matches[1]), &messageData)
-		if err != nil {
+		msg, ok := frameString(data)
+		if !ok {
+			b.metrics.parseFailure("frame_type")
 			return
 		}
+		b.metrics.frameReceived(msg)
 
-		if messageData.Payload == nil || messageData.ID == "" {
+		eventID, payload, ok := parseDataFrame(msg)
+		if !ok {
+			if isDataFrame(msg) {
+				b.metrics.parseFailure("frame")
+			}
 			return
 		}
 
-		payloadMap, ok := messageData.Payload.(map[string]interface{})
+		payloadMap, ok := payload.(map[string]interface{})
 		if !ok {
+			b.metrics.parseFailure("payload")
 			return
 		}
 
-		payloadID, hasID := payloadMap["id"]
+		payloadID, hasID := payloadMap["id"].(string)
 		payloadStatus, hasStatus := payloadMap["status"]
 
-		if !hasID || !hasStatus {
-			return
-		}
-
-		if b.cache != nil {
-			b.emit(fmt.Sprintf("CB:%s", messageData.ID), messageData.Payload)
+		// eventos sem status (como crash.tick-bets) não passam pelo cache de repetidos
+		if b.cache != nil && hasID && hasStatus {
+			b.emit(fmt.Sprintf("CB:%s", eventID), payload)
 
-			if cachedStatus, exists := b.cache[payloadID.(string)]; exists {
+			if cachedStatus, exists := b.cache[payloadID]; exists {
 				if cachedStatus != payloadStatus {
-					b.emit(messageData.ID, messageData.Payload)
-					b.cache[payloadID.(string)] = payloadStatus
+					b.metrics.event(eventID)
+					b.emit(eventID, payload)
+					b.cache[payloadID] = payloadStatus
+				} else {
+					b.metrics.dedupeHit()
 				}
 			} else {
-				b.emit(messageData.ID, messageData.Payload)
-				b.cache[payloadID.(string)] = payloadStatus
+				b.metrics.event(eventID)
+				b.emit(eventID, payload)
+				b.cache[payloadID] = payloadStatus
 			}
 			return
 		}
 
-		b.emit(messageData.ID, messageData.Payload)
+		b.metrics.event(eventID)
+		b.emit(eventID, payload)
 	})
 }
 
@@ -141,6 +144,7 @@ func (b *BlazeSocket) initClose(options SocketOptions) {
 		}
 
 		b.socket.Disconnect()
+		b.metrics.closed()
 
 		code, ok := data.(int)
 		if !ok {
@@ -168,24 +172,40 @@ func (b *BlazeSocket) initClose(options SocketOptions) {
 	})
 }
 
-func (b *BlazeSocket) initOpen(socketType string, token *string) {
-	subscriptions := []string{}
+var gameRooms = map[string]string{
+	"crash":          "crash_room_4",
+	"doubles":        "double_room_1",
+	"crash_2":        "crash_room_1",
+	"crash_neymarjr": "crash_room_3",
+}
 
-	roomMap := map[string]string{
-		"crash":          "crash_room_4",
-		"doubles":        "double_room_1",
-		"crash_2":        "crash_room_1",
-		"crash_neymarjr": "crash_room_3",
+// RoomForGame retorna a sala do Socket.IO inscrita para o tipo de jogo
+func RoomForGame(socketType string) (string, bool) {
+	room, exists := gameRooms[socketType]
+	return room, exists
+}
+
+// GameForRoom retorna o tipo de jogo de uma sala do Socket.IO
+func GameForRoom(room string) (string, bool) {
+	for game, gameRoom := range gameRooms {
+		if gameRoom == room {
+			return game, true
+		}
 	}
+	return "", false
+}
+
+func (b *BlazeSocket) initOpen(socketType string, token *string) {
+	subscriptions := []string{}
 
-	room, exists := roomMap[socketType]
+	room, exists := RoomForGame(socketType)
 	if !exists {
 		b.emit("error", errors.New("missing type of socket"))
 		return
 	}
 
 	subscribeMsg := fmt.Sprintf(`420["cmd",{"id":"subscribe","payload":{"room":"%s"}}]`, room)
-	b.socket.Send(subscribeMsg)
+	b.send(subscribeMsg)
 	subscriptions = append(subscriptions, room)
 
 	if token != nil {
@@ -193,27 +213,20 @@ func (b *BlazeSocket) initOpen(socketType string, token *string) {
 		authMsg2 := fmt.Sprintf(`422["cmd",{"id":"authenticate","payload":{"token":"%s"}}]`, *token)
 		authMsg3 := fmt.Sprintf(`420["cmd",{"id":"authenticate","payload":{"token":"%s"}}]`, *token)
 
-		b.socket.Send(authMsg1)
-		b.socket.Send(authMsg2)
-		b.socket.Send(authMsg3)
+		b.send(authMsg1)
+		b.send(authMsg2)
+		b.send(authMsg3)
 	}
 
 	b.emit("subscriptions", subscriptions)
 }
 
 func (b *BlazeSocket) On(event string, callback func(data interface{})) {
-	if b.callbacks[event] == nil {
-		b.callbacks[event] = make([]func(interface{}), 0)
-	}
-	b.callbacks[event] = append(b.callbacks[event], callback)
+	b.events.On(event, callback)
 }
 
 func (b *BlazeSocket) emit(event string, data interface{}) {
-	if callbacks, exists := b.callbacks[event]; exists {
-		for _, callback := range callbacks {
-			go callback(data)
-		}
-	}
+	b.events.Emit(event, data)
 }
 
 func (b *BlazeSocket) Emit(event string, data interface{}) {
@@ -221,6 +234,11 @@ func (b *BlazeSocket) Emit(event string, data interface{}) {
 }
 
 func (b *BlazeSocket) Send(data interface{}) error {
+	return b.send(data)
+}
+
+func (b *BlazeSocket) send(data interface{}) error {
+	b.metrics.frameSent(data)
 	return b.socket.Send(data)
 }
 
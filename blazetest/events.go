@@ -0,0 +1,67 @@
+package blazetest
+
+import (
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// CrashTicks cria eventos crash.tick para a sala do jogo informado, separados por interval
+func CrashTicks(game string, interval time.Duration, ticks ...blazego.CrashTickEvent) []Event {
+	events := make([]Event, len(ticks))
+	for i, tick := range ticks {
+		events[i] = gameEvent(game, "crash.tick", tick, interval)
+	}
+	return events
+}
+
+// CrashTickBets cria eventos crash.tick-bets para a sala do jogo informado, separados por interval
+func CrashTickBets(game string, interval time.Duration, bets ...blazego.CrashTickBetsEvent) []Event {
+	events := make([]Event, len(bets))
+	for i, bet := range bets {
+		events[i] = gameEvent(game, "crash.tick-bets", bet, interval)
+	}
+	return events
+}
+
+// DoubleTicks cria eventos double.tick para a sala do double, separados por interval
+func DoubleTicks(interval time.Duration, ticks ...blazego.DoubleTickEvent) []Event {
+	events := make([]Event, len(ticks))
+	for i, tick := range ticks {
+		events[i] = gameEvent("doubles", "double.tick", tick, interval)
+	}
+	return events
+}
+
+// ChatMessages cria eventos chat.message para a sala do chat, separados por interval
+func ChatMessages(interval time.Duration, messages ...blazego.ChatMessageEvent) []Event {
+	events := make([]Event, len(messages))
+	for i, message := range messages {
+		events[i] = Event{
+			Room:    blazego.ChatRoom,
+			ID:      "chat.message",
+			Payload: message,
+			Delay:   interval,
+		}
+	}
+	return events
+}
+
+// Script concatena sequências de eventos em um único roteiro
+func Script(sequences ...[]Event) []Event {
+	script := []Event{}
+	for _, sequence := range sequences {
+		script = append(script, sequence...)
+	}
+	return script
+}
+
+func gameEvent(game, id string, payload interface{}, interval time.Duration) Event {
+	room, _ := blazego.RoomForGame(game)
+	return Event{
+		Room:    room,
+		ID:      id,
+		Payload: payload,
+		Delay:   interval,
+	}
+}
@@ -0,0 +1,358 @@
+// Package blazetest implementa um servidor de replicação da Blaze para testes offline.
+//
+// O servidor fala o handshake do Engine.IO v3/Socket.IO usado pela Blaze, aceita os
+// comandos subscribe e authenticate e envia uma sequência roteirizada de eventos para
+// as conexões inscritas em cada sala, com injeção de falhas opcional. O roteiro de cada
+// conexão começa depois da sua primeira inscrição.
+package blazetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Event representa um evento enviado pelo servidor no formato 42["data",{"id":...,"payload":...}]
+type Event struct {
+	Room    string        // sala de destino; vazio envia para qualquer conexão inscrita
+	ID      string        // nome do evento, por exemplo "crash.tick"
+	Payload interface{}   // payload serializado em JSON
+	Delay   time.Duration // espera antes do envio
+}
+
+// Command representa um comando recebido de um cliente
+type Command struct {
+	ID      string          `json:"id"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Faults configura a injeção de falhas aplicada a cada evento do roteiro
+type Faults struct {
+	DropRate      float64       // probabilidade de descartar o evento
+	MalformedRate float64       // probabilidade de enviar um frame inválido antes do evento
+	Delay         time.Duration // atraso extra antes de cada evento
+	Jitter        time.Duration // atraso aleatório adicional de até Jitter
+	CloseAfter    int           // fecha a conexão abruptamente depois de N eventos (0 desabilita)
+	Seed          int64         // semente do gerador aleatório das falhas
+}
+
+// Options configura o servidor
+type Options struct {
+	Script []Event
+	// Loop reinicia o roteiro ao chegar no fim
+	Loop   bool
+	Faults Faults
+}
+
+// idleLoopDelay é a espera entre as passagens do roteiro em Loop que não enviaram nenhum evento,
+// por exemplo quando a conexão não está inscrita em nenhuma sala do roteiro
+const idleLoopDelay = 50 * time.Millisecond
+
+var commandRegex = regexp.MustCompile(`^42\d*\["cmd",\s*({.*})]$`)
+
+// Server é um servidor de replicação falso baseado em httptest
+type Server struct {
+	options  Options
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+
+	mu       sync.Mutex
+	conns    map[*serverConn]struct{}
+	commands []Command
+	changed  chan struct{}
+}
+
+type serverConn struct {
+	mu            sync.Mutex
+	ws            *websocket.Conn
+	subscriptions map[string]bool
+	subscribed    chan struct{}
+	closed        chan struct{}
+	closeOnce     sync.Once
+}
+
+func NewServer(options Options) *Server {
+	s := &Server{
+		options: options,
+		conns:   make(map[*serverConn]struct{}),
+		changed: make(chan struct{}),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// URL retorna a URL websocket no formato usado pela Blaze
+func (s *Server) URL() string {
+	return "ws" + strings.TrimPrefix(s.server.URL, "http") + "/replication/?EIO=3&transport=websocket"
+}
+
+func (s *Server) Close() {
+	s.CloseConnections()
+	s.server.Close()
+}
+
+// CloseConnections fecha abruptamente (sem close frame) todas as conexões abertas
+func (s *Server) CloseConnections() {
+	s.mu.Lock()
+	conns := make([]*serverConn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		conn.close()
+	}
+}
+
+// Commands retorna os comandos recebidos de todos os clientes até o momento
+func (s *Server) Commands() []Command {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	commands := make([]Command, len(s.commands))
+	copy(commands, s.commands)
+	return commands
+}
+
+// WaitCommand aguarda até um comando com o id informado ser recebido
+func (s *Server) WaitCommand(id string, timeout time.Duration) (Command, bool) {
+	deadline := time.After(timeout)
+
+	for {
+		s.mu.Lock()
+		for _, command := range s.commands {
+			if command.ID == id {
+				s.mu.Unlock()
+				return command, true
+			}
+		}
+		changed := s.changed
+		s.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-deadline:
+			return Command{}, false
+		}
+	}
+}
+
+// Broadcast envia o evento imediatamente para as conexões inscritas na sala
+func (s *Server) Broadcast(event Event) {
+	s.mu.Lock()
+	conns := make([]*serverConn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		if conn.subscribedTo(event.Room) {
+			conn.write(EncodeEvent(event))
+		}
+	}
+}
+
+// SendRaw envia um frame arbitrário para todas as conexões
+func (s *Server) SendRaw(frame string) {
+	s.mu.Lock()
+	conns := make([]*serverConn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		conn.write(frame)
+	}
+}
+
+// EncodeEvent retorna o frame Socket.IO de um evento
+func EncodeEvent(event Event) string {
+	data, _ := json.Marshal(map[string]interface{}{
+		"id":      event.ID,
+		"payload": event.Payload,
+	})
+	return fmt.Sprintf(`42["data",%s]`, data)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	ws, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	conn := &serverConn{
+		ws:            ws,
+		subscriptions: make(map[string]bool),
+		subscribed:    make(chan struct{}),
+		closed:        make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	s.conns[conn] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		conn.close()
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+	}()
+
+	sid := fmt.Sprintf("blazetest-%d", time.Now().UnixNano())
+	conn.write(fmt.Sprintf(`0{"sid":"%s","upgrades":[],"pingInterval":25000,"pingTimeout":60000}`, sid))
+	conn.write("40")
+
+	go s.play(conn)
+
+	for {
+		_, message, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		s.receive(conn, string(message))
+	}
+}
+
+func (s *Server) receive(conn *serverConn, message string) {
+	if message == "2" {
+		conn.write("3")
+		return
+	}
+
+	matches := commandRegex.FindStringSubmatch(message)
+	if len(matches) < 2 {
+		return
+	}
+
+	var command Command
+	if err := json.Unmarshal([]byte(matches[1]), &command); err != nil {
+		return
+	}
+
+	if command.ID == "subscribe" {
+		var payload struct {
+			Room string `json:"room"`
+		}
+		if err := json.Unmarshal(command.Payload, &payload); err == nil && payload.Room != "" {
+			conn.subscribe(payload.Room)
+		}
+	}
+
+	s.mu.Lock()
+	s.commands = append(s.commands, command)
+	close(s.changed)
+	s.changed = make(chan struct{})
+	s.mu.Unlock()
+}
+
+func (s *Server) play(conn *serverConn) {
+	select {
+	case <-conn.subscribed:
+	case <-conn.closed:
+		return
+	}
+
+	faults := s.options.Faults
+	random := rand.New(rand.NewSource(faults.Seed))
+	sent := 0
+
+	for {
+		emitted := 0
+
+		for _, event := range s.options.Script {
+			delay := event.Delay + faults.Delay
+			if faults.Jitter > 0 {
+				delay += time.Duration(random.Int63n(int64(faults.Jitter)))
+			}
+
+			if delay > 0 {
+				select {
+				case <-time.After(delay):
+				case <-conn.closed:
+					return
+				}
+			}
+
+			if faults.DropRate > 0 && random.Float64() < faults.DropRate {
+				continue
+			}
+
+			if !conn.subscribedTo(event.Room) {
+				continue
+			}
+
+			if faults.MalformedRate > 0 && random.Float64() < faults.MalformedRate {
+				frame := EncodeEvent(event)
+				conn.write(frame[:random.Intn(len(frame))])
+			}
+
+			if err := conn.write(EncodeEvent(event)); err != nil {
+				return
+			}
+
+			emitted++
+			sent++
+			if faults.CloseAfter > 0 && sent >= faults.CloseAfter {
+				conn.close()
+				return
+			}
+		}
+
+		if !s.options.Loop || len(s.options.Script) == 0 {
+			return
+		}
+
+		if emitted == 0 {
+			select {
+			case <-time.After(idleLoopDelay):
+			case <-conn.closed:
+				return
+			}
+		}
+	}
+}
+
+func (c *serverConn) write(frame string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ws.WriteMessage(websocket.TextMessage, []byte(frame))
+}
+
+func (c *serverConn) subscribe(room string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.subscriptions) == 0 {
+		close(c.subscribed)
+	}
+	c.subscriptions[room] = true
+}
+
+func (c *serverConn) subscribedTo(room string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if room == "" {
+		return len(c.subscriptions) > 0
+	}
+	return c.subscriptions[room]
+}
+
+func (c *serverConn) close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.ws.UnderlyingConn().Close()
+	})
+}
@@ -0,0 +1,318 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"plugin"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/backtest"
+	"github.com/viniciusgdr/blazego/sim"
+)
+
+const strategyHelp = `estratégia; crash: autocashout:<alvo>, martingale:<alvo>[:<limite>];
+double: flat:<cor>, martingale:<cor>[:<limite>], dalembert:<cor>, white-gap:<rodadas>;
+plugin: plugin:<arquivo.so> (símbolo CrashStrategy ou DoubleStrategy)`
+
+func runBacktest(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("backtest", flag.ContinueOnError)
+	game := fs.String("game", "crash", "jogo: crash, crash_2, crash_neymarjr ou doubles")
+	spec := fs.String("strategy", "", strategyHelp)
+	amount := fs.Float64("amount", 1, "valor base de cada aposta")
+	var options backtest.Options
+	fs.Float64Var(&options.Bankroll, "bankroll", 100, "banca inicial")
+	fs.Float64Var(&options.RuinThreshold, "ruin", 0, "valor da banca considerado ruína")
+	fs.IntVar(&options.Simulations, "simulations", 1000, "reamostragens para estimar a probabilidade de ruína (0 desabilita)")
+	fs.Int64Var(&options.Seed, "seed", 1, "semente das reamostragens e da simulação")
+	simulate := fs.Int("simulate", 0, "usa N rodadas do simulador no lugar de uma gravação (não aceita arquivos)")
+	csvPath := fs.String("csv", "", "exporta o extrato em CSV para o arquivo")
+	jsonOutput := fs.Bool("json", false, "imprime o resumo em JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Uso: blazego backtest --strategy <estratégia> [flags] <arquivo>...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *spec == "" {
+		fs.Usage()
+		return errors.New("missing --strategy")
+	}
+	if *simulate <= 0 && fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("missing session file or --simulate")
+	}
+	if *simulate > 0 && fs.NArg() > 0 {
+		return errors.New("--simulate cannot be combined with session files")
+	}
+	if _, exists := blazego.RoomForGame(*game); !exists {
+		return fmt.Errorf("unknown game %q", *game)
+	}
+
+	frames, err := loadSessions(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	var (
+		summary backtest.Summary
+		ledger  [][]string
+	)
+
+	if *game == "doubles" {
+		strategy, err := parseDoubleStrategy(*spec, *amount)
+		if err != nil {
+			return err
+		}
+
+		rounds := blazego.DoubleRoundsFromFrames(frames)
+		if *simulate > 0 {
+			simulator := sim.NewDoubleSimulator(sim.DoubleOptions{Seed: options.Seed})
+			rounds = sim.DoubleRounds(time.Now(), simulator.Rounds(*simulate))
+		}
+		if len(rounds) == 0 {
+			return errors.New("no finished rounds found")
+		}
+
+		report := backtest.RunDouble(rounds, strategy, options)
+		summary = report.Summary
+		ledger = doubleLedgerRecords(report.Ledger)
+	} else {
+		strategy, err := parseCrashStrategy(*spec, *amount)
+		if err != nil {
+			return err
+		}
+
+		results := blazego.CrashResultsFromFrames(frames, *game)
+		if *simulate > 0 {
+			simulator := sim.NewCrashSimulator(sim.CrashOptions{Game: *game, Seed: options.Seed})
+			results = sim.CrashResults(*game, time.Now(), simulator.Rounds(*simulate))
+		}
+		if len(results) == 0 {
+			return errors.New("no finished rounds found")
+		}
+
+		report := backtest.RunCrash(results, strategy, options)
+		summary = report.Summary
+		ledger = crashLedgerRecords(report.Ledger)
+	}
+
+	if *csvPath != "" {
+		if err := writeCSV(*csvPath, ledger); err != nil {
+			return err
+		}
+	}
+
+	if *jsonOutput {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(summary)
+	}
+
+	printSummary(stdout, *game, *spec, summary)
+	return nil
+}
+
+// parseCrashStrategy interpreta especificações como "autocashout:2.0" ou "plugin:estrategia.so"
+func parseCrashStrategy(spec string, amount float64) (backtest.CrashStrategy, error) {
+	name, args := splitStrategy(spec)
+
+	switch name {
+	case "autocashout":
+		target, err := floatArg(args, 0, 0)
+		if err != nil || target <= 1 {
+			return nil, fmt.Errorf("invalid cash out target in %q", spec)
+		}
+		return backtest.AutoCashout{Amount: amount, Target: target}, nil
+
+	case "martingale":
+		target, err := floatArg(args, 0, 2)
+		if err != nil || target <= 1 {
+			return nil, fmt.Errorf("invalid cash out target in %q", spec)
+		}
+		maxStake, err := floatArg(args, 1, 0)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max stake in %q", spec)
+		}
+		return backtest.CrashMartingale{Base: amount, Target: target, MaxStake: maxStake}, nil
+
+	case "plugin":
+		return lookupStrategy[backtest.CrashStrategy](strings.Join(args, ":"), "CrashStrategy")
+	}
+
+	return nil, fmt.Errorf("unknown crash strategy %q", name)
+}
+
+// parseDoubleStrategy interpreta especificações como "martingale:red" ou "white-gap:20"
+func parseDoubleStrategy(spec string, amount float64) (backtest.DoubleStrategy, error) {
+	name, args := splitStrategy(spec)
+
+	if name == "plugin" {
+		return lookupStrategy[backtest.DoubleStrategy](strings.Join(args, ":"), "DoubleStrategy")
+	}
+
+	if name == "white-gap" {
+		gap := 20
+		if len(args) > 0 {
+			parsed, err := strconv.Atoi(args[0])
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("invalid gap in %q", spec)
+			}
+			gap = parsed
+		}
+		return backtest.WhiteGap{Gap: gap, Amount: amount}, nil
+	}
+
+	color := blazego.DoubleColorRed
+	if len(args) > 0 {
+		parsed, err := blazego.ParseDoubleColor(args[0])
+		if err != nil {
+			return nil, err
+		}
+		color = parsed
+	}
+
+	switch name {
+	case "flat":
+		return backtest.FlatBet{Color: color, Amount: amount}, nil
+
+	case "martingale":
+		maxStake, err := floatArg(args, 1, 0)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max stake in %q", spec)
+		}
+		return backtest.Martingale{Color: color, Base: amount, MaxStake: maxStake}, nil
+
+	case "dalembert":
+		return backtest.DAlembert{Color: color, Base: amount, Unit: amount}, nil
+	}
+
+	return nil, fmt.Errorf("unknown double strategy %q", name)
+}
+
+func splitStrategy(spec string) (string, []string) {
+	parts := strings.Split(spec, ":")
+	return parts[0], parts[1:]
+}
+
+func floatArg(args []string, index int, fallback float64) (float64, error) {
+	if index >= len(args) || args[index] == "" {
+		return fallback, nil
+	}
+	return strconv.ParseFloat(strings.TrimSuffix(args[index], "x"), 64)
+}
+
+// lookupStrategy carrega a estratégia exportada por um plugin Go (go build -buildmode=plugin)
+func lookupStrategy[T any](path, symbolName string) (T, error) {
+	var strategy T
+
+	if path == "" {
+		return strategy, errors.New("missing plugin path")
+	}
+
+	loaded, err := plugin.Open(path)
+	if err != nil {
+		return strategy, err
+	}
+
+	symbol, err := loaded.Lookup(symbolName)
+	if err != nil {
+		return strategy, err
+	}
+
+	// variáveis são retornadas como ponteiro pelo Lookup
+	if pointer, ok := symbol.(*T); ok {
+		return *pointer, nil
+	}
+	if value, ok := symbol.(T); ok {
+		return value, nil
+	}
+
+	return strategy, fmt.Errorf("plugin symbol %s has unexpected type %T", symbolName, symbol)
+}
+
+func crashLedgerRecords(ledger []backtest.CrashEntry) [][]string {
+	records := [][]string{{"round", "id", "crash_point", "amount", "cash_out", "won", "profit", "bankroll"}}
+	for _, entry := range ledger {
+		records = append(records, []string{
+			strconv.Itoa(entry.Round),
+			entry.ID,
+			formatFloat(entry.CrashPoint),
+			formatFloat(entry.Amount),
+			formatFloat(entry.CashOut),
+			strconv.FormatBool(entry.Won),
+			formatFloat(entry.Profit),
+			formatFloat(entry.Bankroll),
+		})
+	}
+	return records
+}
+
+func doubleLedgerRecords(ledger []backtest.DoubleEntry) [][]string {
+	records := [][]string{{"round", "id", "roll", "color", "bets", "stake", "payout", "profit", "bankroll"}}
+	for _, entry := range ledger {
+		bets := make([]string, len(entry.Bets))
+		for i, bet := range entry.Bets {
+			bets[i] = bet.Color.String() + ":" + formatFloat(bet.Amount)
+		}
+
+		records = append(records, []string{
+			strconv.Itoa(entry.Round),
+			entry.ID,
+			strconv.Itoa(entry.Roll),
+			entry.Color.String(),
+			strings.Join(bets, ";"),
+			formatFloat(entry.Stake),
+			formatFloat(entry.Payout),
+			formatFloat(entry.Profit),
+			formatFloat(entry.Bankroll),
+		})
+	}
+	return records
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func writeCSV(path string, records [][]string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(file)
+	writer.WriteAll(records)
+	if err := writer.Error(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func printSummary(out io.Writer, game, spec string, summary backtest.Summary) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "jogo\t%s\n", game)
+	fmt.Fprintf(w, "estratégia\t%s\n", spec)
+	fmt.Fprintf(w, "rodadas\t%d\n", summary.Rounds)
+	fmt.Fprintf(w, "apostas\t%d (%d vitórias, %.1f%%)\n", summary.Bets, summary.Wins, 100*summary.HitRate)
+	fmt.Fprintf(w, "apostado\t%.2f\n", summary.Wagered)
+	fmt.Fprintf(w, "banca\t%.2f → %.2f\n", summary.StartBankroll, summary.FinalBankroll)
+	fmt.Fprintf(w, "lucro\t%+.2f\n", summary.Profit)
+	fmt.Fprintf(w, "drawdown máximo\t%.2f (%.1f%%)\n", summary.MaxDrawdown, summary.MaxDrawdownPercent)
+	fmt.Fprintf(w, "ruína\t%t\n", summary.Ruined)
+	fmt.Fprintf(w, "probabilidade de ruína\t%.1f%%\n", 100*summary.RuinProbability)
+}
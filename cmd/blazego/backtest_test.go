@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func completeFrame(at time.Duration, id string, point float64) blazego.SessionFrame {
+	frame := statsFrame("a", at, id, "complete")
+	frame.Data = fmt.Sprintf(`42["data",{"id":"crash.tick","payload":{"id":%q,"status":"complete","crash_point":"%v"}}]`, id, point)
+	return frame
+}
+
+func TestBacktestSessionSet(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "crash.jsonl")
+
+	// c2 está só no segmento rotacionado e a segunda gravação é mais antiga que as duas
+	writeFrames(t, filepath.Join(dir, "crash-20240101T000000.000000000.jsonl"), completeFrame(time.Minute, "c2", 3))
+	writeFrames(t, path, completeFrame(2*time.Minute, "c3", 1.5))
+	other := filepath.Join(dir, "other.jsonl")
+	writeFrames(t, other, completeFrame(0, "c1", 1.5))
+
+	output := captureStdout(t)
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	args := []string{"--strategy", "martingale:2", "--amount", "1", "--simulations", "0", "--json", path, other}
+	if err := runBacktest(ctx, args); err != nil {
+		t.Fatal(err)
+	}
+
+	// em ordem, c1 perde 1, c2 ganha 2 com a aposta dobrada e c3 perde 1
+	for _, want := range []string{`"rounds": 3`, `"wins": 1`, `"profit": 0`} {
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, output)
+		}
+	}
+}
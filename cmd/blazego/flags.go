@@ -0,0 +1,121 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// connectionFlags espelha os campos de blazego.Connection
+type connectionFlags struct {
+	game    string
+	url     string
+	token   string
+	host    string
+	origin  string
+	headers headerFlags
+	ping    time.Duration
+	noCache bool
+	metrics string
+}
+
+func (f *connectionFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.game, "game", "crash", "jogo: crash, doubles, crash_2, crash_neymarjr ou chat")
+	fs.StringVar(&f.url, "url", "", "URL do websocket (padrão: URL da Blaze para o jogo)")
+	fs.StringVar(&f.token, "token", "", "token de autenticação")
+	fs.StringVar(&f.host, "host", "", "cabeçalho Host da conexão")
+	fs.StringVar(&f.origin, "origin", "", "cabeçalho Origin da conexão")
+	fs.Var(&f.headers, "header", "cabeçalho extra no formato Nome: valor (pode repetir)")
+	fs.DurationVar(&f.ping, "ping", 10*time.Second, "intervalo entre pings")
+	fs.BoolVar(&f.noCache, "no-dedupe", false, "não ignora eventos repetidos de uma rodada")
+	fs.StringVar(&f.metrics, "metrics", "", "endereço para servir as métricas do Prometheus em /metrics (ex.: :9090)")
+}
+
+// connection monta a blazego.Connection a partir das flags, iniciando o servidor de métricas quando pedido
+func (f *connectionFlags) connection() (blazego.Connection, error) {
+	conn := blazego.Connection{
+		Web:      "blaze",
+		GameType: f.game,
+	}
+
+	if f.game == "chat" {
+		conn.Web = "blaze-chat"
+		conn.GameType = ""
+	} else if _, exists := blazego.RoomForGame(f.game); !exists {
+		return conn, fmt.Errorf("unknown game %q", f.game)
+	}
+
+	if f.url != "" {
+		conn.URL = &f.url
+	}
+	if f.token != "" {
+		conn.Token = &f.token
+	}
+
+	if f.host != "" || f.origin != "" || len(f.headers) > 0 {
+		conn.Options = &blazego.ConnectionOptions{Headers: map[string]string(f.headers)}
+		if f.host != "" {
+			conn.Options.Host = &f.host
+		}
+		if f.origin != "" {
+			conn.Options.Origin = &f.origin
+		}
+	}
+
+	if f.ping > 0 {
+		ping := int(f.ping.Milliseconds())
+		conn.TimeoutPing = &ping
+	}
+
+	cache := !f.noCache
+	conn.CacheIgnoreRepeatedEvents = &cache
+
+	if f.metrics != "" {
+		listener, err := net.Listen("tcp", f.metrics)
+		if err != nil {
+			return conn, err
+		}
+
+		conn.Metrics = blazego.NewMetrics()
+		mux := http.NewServeMux()
+		mux.Handle("GET /metrics", conn.Metrics)
+		go func() {
+			// o listener nunca é fechado, então Serve só retorna com erro
+			if err := http.Serve(listener, mux); err != nil {
+				fmt.Fprintf(os.Stderr, "blazego: metrics server: %v\n", err)
+			}
+		}()
+	}
+
+	return conn, nil
+}
+
+// headerFlags acumula as flags -header repetidas
+type headerFlags map[string]string
+
+func (h *headerFlags) String() string {
+	pairs := []string{}
+	for name, value := range *h {
+		pairs = append(pairs, name+": "+value)
+	}
+	return strings.Join(pairs, ", ")
+}
+
+func (h *headerFlags) Set(value string) error {
+	name, headerValue, found := strings.Cut(value, ":")
+	if !found || strings.TrimSpace(name) == "" {
+		return fmt.Errorf("invalid header %q, expected Name: value", value)
+	}
+
+	if *h == nil {
+		*h = make(headerFlags)
+	}
+	(*h)[strings.TrimSpace(name)] = strings.TrimSpace(headerValue)
+	return nil
+}
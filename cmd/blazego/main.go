@@ -0,0 +1,84 @@
+// Comando blazego acompanha os jogos e o chat da Blaze pelo terminal.
+//
+// Uso:
+//
+//	blazego <comando> [flags]
+//
+// Comandos:
+//
+//	tail      imprime os eventos de um jogo ou do chat em tempo real
+//	record    grava a sessão de um jogo ou do chat em JSON Lines
+//	replay    reproduz uma sessão gravada
+//	stats     calcula estatísticas de sessões gravadas
+//	backtest  avalia uma estratégia sobre rodadas gravadas ou simuladas
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// stdout recebe a saída dos comandos
+var stdout io.Writer = os.Stdout
+
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, args []string) error
+}
+
+var commands = []command{
+	{"tail", "imprime os eventos de um jogo ou do chat em tempo real", runTail},
+	{"record", "grava a sessão de um jogo ou do chat em JSON Lines", runRecord},
+	{"replay", "reproduz uma sessão gravada", runReplay},
+	{"stats", "calcula estatísticas de sessões gravadas", runStats},
+	{"backtest", "avalia uma estratégia sobre rodadas gravadas ou simuladas", runBacktest},
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := flag.Arg(0)
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		err := cmd.run(ctx, flag.Args()[1:])
+		stop()
+
+		if err != nil && !errors.Is(err, flag.ErrHelp) && !errors.Is(err, context.Canceled) {
+			fmt.Fprintf(os.Stderr, "blazego %s: %v\n", name, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "blazego: comando desconhecido %q\n", name)
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Uso: blazego <comando> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Comandos:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Use "blazego <comando> -h" para ver as flags de cada comando.`)
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/blazetest"
+)
+
+const testTimeout = 5 * time.Second
+
+// syncBuffer permite ler a saída enquanto um comando ainda escreve nela
+type syncBuffer struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.String()
+}
+
+func captureStdout(t *testing.T) *syncBuffer {
+	t.Helper()
+
+	buffer := &syncBuffer{}
+	previous := stdout
+	stdout = buffer
+	t.Cleanup(func() { stdout = previous })
+	return buffer
+}
+
+func crashRound(id string, point float64) []blazetest.Event {
+	crashPoint := blazego.Float64String(point)
+	return blazetest.CrashTicks("crash", 0,
+		blazego.CrashTickEvent{ID: id, Status: "waiting"},
+		blazego.CrashTickEvent{ID: id, Status: "graphing"},
+		blazego.CrashTickEvent{ID: id, Status: "complete", CrashPoint: &crashPoint},
+	)
+}
+
+func newTestServer(t *testing.T) *blazetest.Server {
+	t.Helper()
+
+	script := blazetest.Script(crashRound("c1", 1.2), crashRound("c2", 4))
+	// dá tempo para os callbacks serem registrados depois da conexão
+	script[0].Delay = 100 * time.Millisecond
+
+	server := blazetest.NewServer(blazetest.Options{Script: script})
+	t.Cleanup(server.Close)
+	return server
+}
+
+// runUntil executa o comando até check aceitar o conteúdo observado, cancelando o contexto em seguida
+func runUntil(t *testing.T, run func(ctx context.Context) error, check func() bool) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- run(ctx) }()
+
+	deadline := time.After(testTimeout)
+	for !check() {
+		select {
+		case err := <-done:
+			t.Fatalf("command finished early: %v", err)
+		case <-deadline:
+			t.Fatal("timeout")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil && !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+}
+
+// recordSession grava a sessão do servidor de teste e retorna o caminho do arquivo
+func recordSession(t *testing.T) string {
+	t.Helper()
+
+	server := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "crash.jsonl")
+
+	runUntil(t, func(ctx context.Context) error {
+		return runRecord(ctx, []string{"--url", server.URL(), "--game", "crash", "--out", path, "--quiet"})
+	}, func() bool {
+		data, _ := os.ReadFile(path)
+		return strings.Contains(string(data), `\"crash_point\":4`)
+	})
+
+	return path
+}
+
+func TestTail(t *testing.T) {
+	server := newTestServer(t)
+	output := captureStdout(t)
+
+	runUntil(t, func(ctx context.Context) error {
+		return runTail(ctx, []string{"--url", server.URL(), "--game", "crash", "--no-color"})
+	}, func() bool {
+		return strings.Contains(output.String(), "crash c2 crash 4.00x")
+	})
+
+	if !strings.Contains(output.String(), "crash c1 crash 1.20x") {
+		t.Errorf("output = %s", output)
+	}
+}
+
+func TestCommands(t *testing.T) {
+	path := recordSession(t)
+
+	tests := []struct {
+		name    string
+		run     func(ctx context.Context, args []string) error
+		args    []string
+		want    []string
+		wantErr string
+	}{
+		{"replay", runReplay, []string{"--speed", "max", "--no-color", path}, []string{"crash c1 crash 1.20x", "crash c2 crash 4.00x"}, ""},
+		{"replay json", runReplay, []string{"--speed", "max", "--json", path}, []string{`"event":"crash.tick"`, `"crash_point":4`}, ""},
+		{"replay missing file", runReplay, []string{}, nil, "missing session file"},
+		{"replay invalid speed", runReplay, []string{"--speed", "fast", path}, nil, "invalid speed"},
+		{"stats", runStats, []string{"--json", path}, []string{`"game": "crash"`, `"rounds": 2`, `"max": 4`}, ""},
+		{"stats table", runStats, []string{path}, []string{"crash"}, ""},
+		{"backtest", runBacktest, []string{"--strategy", "autocashout:2", "--simulations", "0", "--json", path}, []string{`"rounds": 2`, `"wins": 1`, `"profit": 0`}, ""},
+		{"backtest simulate", runBacktest, []string{"--strategy", "flat:red", "--game", "doubles", "--simulate", "20", "--simulations", "0", "--json"}, []string{`"rounds": 20`}, ""},
+		{"backtest simulate with files", runBacktest, []string{"--strategy", "autocashout:2", "--simulate", "20", path}, nil, "--simulate cannot be combined with session files"},
+		{"backtest missing strategy", runBacktest, []string{path}, nil, "missing --strategy"},
+		{"backtest unknown game", runBacktest, []string{"--strategy", "autocashout:2", "--game", "roleta", path}, nil, `unknown game "roleta"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := captureStdout(t)
+
+			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+			defer cancel()
+
+			err := test.run(ctx, test.args)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("err = %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range test.want {
+				if !strings.Contains(output.String(), want) {
+					t.Errorf("output does not contain %q:\n%s", want, output)
+				}
+			}
+		})
+	}
+}
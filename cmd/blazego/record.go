@@ -0,0 +1,62 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func runRecord(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("record", flag.ContinueOnError)
+	var connFlags connectionFlags
+	connFlags.register(fs)
+	var output outputFlags
+	output.register(fs)
+	var recorderOptions blazego.RecorderOptions
+	fs.StringVar(&recorderOptions.Path, "out", "", "arquivo JSON Lines da gravação (obrigatório)")
+	fs.Int64Var(&recorderOptions.MaxBytes, "max-bytes", 0, "rotaciona o arquivo ao ultrapassar o tamanho em bytes (0 desabilita)")
+	fs.DurationVar(&recorderOptions.MaxAge, "max-age", 0, "rotaciona o arquivo depois do intervalo (0 desabilita)")
+	fs.BoolVar(&recorderOptions.DisableCompression, "no-compress", false, "mantém os arquivos rotacionados sem gzip")
+	quiet := fs.Bool("quiet", false, "não imprime os eventos durante a gravação")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Uso: blazego record --game <jogo> --out <arquivo> [flags]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if recorderOptions.Path == "" {
+		fs.Usage()
+		return errors.New("missing --out")
+	}
+
+	conn, err := connFlags.connection()
+	if err != nil {
+		return err
+	}
+
+	recorder, err := blazego.NewSessionRecorder(recorderOptions)
+	if err != nil {
+		return err
+	}
+	conn.Recorder = recorder
+
+	handle := output.printer(connFlags.game).print
+	if *quiet {
+		handle = func(event string, data interface{}) {}
+	}
+
+	err = tail(ctx, conn, output.events(conn), handle)
+	closeErr := recorder.Close()
+
+	// interromper com Ctrl+C é o fim normal da gravação
+	if err == nil || errors.Is(err, context.Canceled) {
+		return cmp.Or(closeErr, err)
+	}
+	return err
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func runReplay(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	var output outputFlags
+	output.register(fs)
+	game := fs.String("game", "", "jogo reproduzido: crash, doubles, crash_2, crash_neymarjr ou chat (padrão: sala do primeiro frame)")
+	speed := fs.String("speed", "1x", `velocidade da reprodução, por exemplo 1x, 4x ou "max"`)
+	noCache := fs.Bool("no-dedupe", false, "não ignora eventos repetidos de uma rodada")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Uso: blazego replay [flags] <arquivo>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("missing session file")
+	}
+
+	replaySpeed, err := parseSpeed(*speed)
+	if err != nil {
+		return err
+	}
+
+	frames, err := blazego.LoadSessionSet(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if *game == "" {
+		*game, err = sessionGame(frames)
+		if err != nil {
+			return err
+		}
+	}
+
+	connFlags := connectionFlags{game: *game, noCache: *noCache}
+	conn, err := connFlags.connection()
+	if err != nil {
+		return err
+	}
+	conn.Replay = &blazego.ReplayOptions{Frames: frames, Speed: replaySpeed}
+
+	return tail(ctx, conn, output.events(conn), output.printer(*game).print)
+}
+
+// parseSpeed interpreta valores como "4x", "0.5" ou "max" (o mais rápido possível)
+func parseSpeed(value string) (float64, error) {
+	if value == "max" {
+		return 0, nil
+	}
+
+	speed, err := strconv.ParseFloat(strings.TrimSuffix(value, "x"), 64)
+	if err != nil || speed <= 0 {
+		return 0, fmt.Errorf("invalid speed %q", value)
+	}
+	return speed, nil
+}
+
+// sessionGame retorna o jogo da sala do primeiro frame gravado
+func sessionGame(frames []blazego.SessionFrame) (string, error) {
+	for _, frame := range frames {
+		if frame.Room == blazego.ChatRoom {
+			return "chat", nil
+		}
+		if game, exists := blazego.GameForRoom(frame.Room); exists {
+			return game, nil
+		}
+	}
+	return "", errors.New("could not detect the game of the session, use --game")
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// sessionStats resume os frames gravados de um jogo
+type sessionStats struct {
+	Game        string       `json:"game"`
+	Frames      int          `json:"frames"`
+	First       time.Time    `json:"first"`
+	Last        time.Time    `json:"last"`
+	Connections int          `json:"connections"`
+	Reconnects  int          `json:"reconnects"`
+	Gaps        []streamGap  `json:"gaps"`
+	Rounds      int          `json:"rounds"`
+	Crash       *crashStats  `json:"crash,omitempty"`
+	Double      *doubleStats `json:"double,omitempty"`
+	Bets        *betVolume   `json:"bets,omitempty"`
+}
+
+// streamGap é um intervalo sem frames maior que o limite configurado
+type streamGap struct {
+	From     time.Time     `json:"from"`
+	To       time.Time     `json:"to"`
+	Duration time.Duration `json:"duration"`
+	// Reconnect indica que os frames antes e depois do intervalo vieram de conexões diferentes
+	Reconnect bool `json:"reconnect"`
+}
+
+type crashStats struct {
+	blazego.CrashStats
+	Distribution []crashBucket `json:"distribution"`
+}
+
+type crashBucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"` // 0 indica faixa sem limite superior
+	Count int     `json:"count"`
+}
+
+var crashBucketLimits = []float64{1, 1.5, 2, 3, 5, 10, 100}
+
+type doubleStats struct {
+	blazego.DoubleStats
+	// LongestStreaks é a maior sequência de cada cor
+	LongestStreaks map[blazego.DoubleColor]int `json:"longest_streaks"`
+	// Streaks conta as sequências encerradas por tamanho
+	Streaks map[int]int `json:"streaks"`
+}
+
+// betVolume soma as apostas das rodadas com dados de apostas
+type betVolume struct {
+	Rounds      int     `json:"rounds"`
+	Bets        int     `json:"bets"`
+	TotalBet    float64 `json:"total_bet"`
+	TotalPaid   float64 `json:"total_paid"`
+	HouseProfit float64 `json:"house_profit"`
+}
+
+func runStats(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	jsonOutput := fs.Bool("json", false, "imprime as estatísticas em JSON")
+	gap := fs.Duration("gap", time.Minute, "intervalo sem frames considerado uma falha no fluxo")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Uso: blazego stats [flags] <arquivo>...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("missing session file")
+	}
+
+	frames, err := loadSessions(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	stats := computeStats(frames, *gap)
+
+	if *jsonOutput {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(stats)
+	}
+
+	printStats(stdout, stats)
+	return nil
+}
+
+// loadSessions lê cada sessão com os arquivos rotacionados dela e ordena os frames de todas pelo
+// horário de recebimento
+func loadSessions(paths []string) ([]blazego.SessionFrame, error) {
+	frames := []blazego.SessionFrame{}
+	for _, path := range paths {
+		loaded, err := blazego.LoadSessionSet(path)
+		if err != nil {
+			return nil, err
+		}
+		frames = append(frames, loaded...)
+	}
+
+	slices.SortStableFunc(frames, func(a, b blazego.SessionFrame) int {
+		return a.Time.Compare(b.Time)
+	})
+	return frames, nil
+}
+
+// computeStats agrupa os frames por jogo, na ordem em que cada jogo aparece na gravação
+func computeStats(frames []blazego.SessionFrame, gap time.Duration) []sessionStats {
+	games := []string{}
+	byGame := make(map[string][]blazego.SessionFrame)
+
+	for _, frame := range frames {
+		game := frameGame(frame)
+		if _, exists := byGame[game]; !exists {
+			games = append(games, game)
+		}
+		byGame[game] = append(byGame[game], frame)
+	}
+
+	stats := make([]sessionStats, 0, len(games))
+	for _, game := range games {
+		stats = append(stats, computeGameStats(game, byGame[game], gap))
+	}
+	return stats
+}
+
+func frameGame(frame blazego.SessionFrame) string {
+	if frame.Room == blazego.ChatRoom {
+		return "chat"
+	}
+	if game, exists := blazego.GameForRoom(frame.Room); exists {
+		return game
+	}
+	if frame.Room == "" {
+		return "unknown"
+	}
+	return frame.Room
+}
+
+func computeGameStats(game string, frames []blazego.SessionFrame, gap time.Duration) sessionStats {
+	stats := sessionStats{
+		Game:   game,
+		Frames: len(frames),
+		First:  frames[0].Time,
+		Last:   frames[len(frames)-1].Time,
+		Gaps:   []streamGap{},
+	}
+
+	connections := make(map[string]struct{})
+	for i, frame := range frames {
+		// uma reconexão é o primeiro frame de uma conexão nova; conexões simultâneas não contam de novo
+		if _, seen := connections[frame.ConnectionID]; !seen && i > 0 {
+			stats.Reconnects++
+		}
+		connections[frame.ConnectionID] = struct{}{}
+		if i == 0 {
+			continue
+		}
+
+		previous := frames[i-1]
+		reconnect := frame.ConnectionID != previous.ConnectionID
+
+		// a troca de conexão sem tempo perdido não é uma falha no fluxo
+		if elapsed := frame.Time.Sub(previous.Time); elapsed > gap {
+			stats.Gaps = append(stats.Gaps, streamGap{
+				From:      previous.Time,
+				To:        frame.Time,
+				Duration:  elapsed,
+				Reconnect: reconnect,
+			})
+		}
+	}
+	stats.Connections = len(connections)
+
+	switch game {
+	case "doubles":
+		rounds := blazego.DoubleRoundsFromFrames(frames)
+		stats.Rounds = len(rounds)
+		stats.Double = newDoubleStats(rounds)
+		stats.Bets = doubleBetVolume(blazego.DoubleBetsFromFrames(frames))
+
+	case "chat", "unknown":
+
+	default:
+		results := blazego.CrashResultsFromFrames(frames, game)
+		stats.Rounds = len(results)
+		stats.Crash = newCrashStats(results)
+		stats.Bets = crashBetVolume(blazego.CrashBetsFromFrames(frames, game, 0))
+	}
+
+	return stats
+}
+
+func newCrashStats(results []blazego.CrashResult) *crashStats {
+	stats := &crashStats{
+		CrashStats:   blazego.NewCrashStats(results),
+		Distribution: make([]crashBucket, len(crashBucketLimits)),
+	}
+
+	for i, limit := range crashBucketLimits {
+		stats.Distribution[i].Min = limit
+		if i+1 < len(crashBucketLimits) {
+			stats.Distribution[i].Max = crashBucketLimits[i+1]
+		}
+	}
+
+	for _, result := range results {
+		for i := len(stats.Distribution) - 1; i >= 0; i-- {
+			if result.CrashPoint >= stats.Distribution[i].Min {
+				stats.Distribution[i].Count++
+				break
+			}
+		}
+	}
+
+	return stats
+}
+
+func newDoubleStats(rounds []blazego.DoubleRound) *doubleStats {
+	stats := &doubleStats{
+		DoubleStats:    blazego.NewDoubleStats(rounds),
+		LongestStreaks: make(map[blazego.DoubleColor]int),
+		Streaks:        make(map[int]int),
+	}
+
+	length := 0
+	for i, round := range rounds {
+		length++
+		stats.LongestStreaks[round.Color] = max(stats.LongestStreaks[round.Color], length)
+
+		if i+1 == len(rounds) || rounds[i+1].Color != round.Color {
+			stats.Streaks[length]++
+			length = 0
+		}
+	}
+
+	return stats
+}
+
+func crashBetVolume(summaries []blazego.CrashBetsSummary) *betVolume {
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	volume := &betVolume{Rounds: len(summaries)}
+	for _, summary := range summaries {
+		volume.Bets += summary.Players
+		volume.TotalBet += summary.TotalBet
+		volume.TotalPaid += summary.TotalWon
+		volume.HouseProfit += summary.HouseProfit
+	}
+	return volume
+}
+
+func doubleBetVolume(summaries []blazego.DoubleBetsSummary) *betVolume {
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	volume := &betVolume{Rounds: len(summaries)}
+	for _, summary := range summaries {
+		volume.Bets += summary.TotalBets
+		volume.TotalBet += summary.TotalAmount
+		volume.TotalPaid += summary.Payout
+		volume.HouseProfit += summary.HouseProfit
+	}
+	return volume
+}
+
+func printStats(out io.Writer, stats []sessionStats) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	for i, game := range stats {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "== %s ==\n", game.Game)
+		fmt.Fprintf(w, "frames\t%d\n", game.Frames)
+		fmt.Fprintf(w, "período\t%s → %s (%s)\n", game.First.Format(time.DateTime), game.Last.Format(time.DateTime), game.Last.Sub(game.First).Round(time.Second))
+		fmt.Fprintf(w, "conexões\t%d (%d reconexões)\n", game.Connections, game.Reconnects)
+		fmt.Fprintf(w, "falhas no fluxo\t%d\n", len(game.Gaps))
+		for _, gap := range game.Gaps {
+			reconnect := ""
+			if gap.Reconnect {
+				reconnect = " reconexão"
+			}
+			fmt.Fprintf(w, "\t%s %s%s\n", gap.From.Format(time.TimeOnly), gap.Duration.Round(time.Millisecond), reconnect)
+		}
+
+		if game.Game == "chat" || game.Game == "unknown" {
+			continue
+		}
+		fmt.Fprintf(w, "rodadas\t%d\n", game.Rounds)
+
+		if crash := game.Crash; crash != nil && crash.Count > 0 {
+			fmt.Fprintf(w, "crash\tmín %.2fx  mediana %.2fx  média %.2fx  p90 %.2fx  p99 %.2fx  máx %.2fx\n",
+				crash.Min, crash.Median, crash.Mean, crash.P90, crash.P99, crash.Max)
+			if crash.BonusRounds > 0 {
+				fmt.Fprintf(w, "rodadas bônus\t%d\n", crash.BonusRounds)
+			}
+			for _, bucket := range crash.Distribution {
+				label := fmt.Sprintf("%gx+", bucket.Min)
+				if bucket.Max > 0 {
+					label = fmt.Sprintf("%gx–%gx", bucket.Min, bucket.Max)
+				}
+				fmt.Fprintf(w, "\t%s\t%d\t%5.1f%%\n", label, bucket.Count, 100*float64(bucket.Count)/float64(crash.Count))
+			}
+		}
+
+		if double := game.Double; double != nil && double.Count > 0 {
+			for _, color := range []blazego.DoubleColor{blazego.DoubleColorRed, blazego.DoubleColorBlack, blazego.DoubleColorWhite} {
+				fmt.Fprintf(w, "%s\t%5.1f%%\tmaior sequência %d\n", color, 100*double.Frequency(color), double.LongestStreaks[color])
+			}
+			lengths := []int{}
+			for length := range double.Streaks {
+				lengths = append(lengths, length)
+			}
+			slices.Sort(lengths)
+			for _, length := range lengths {
+				fmt.Fprintf(w, "\tsequências de %d\t%d\n", length, double.Streaks[length])
+			}
+			fmt.Fprintf(w, "desde o último branco\t%d\n", double.SinceWhite)
+		}
+
+		if bets := game.Bets; bets != nil {
+			fmt.Fprintf(w, "apostas\t%d em %d rodadas\n", bets.Bets, bets.Rounds)
+			fmt.Fprintf(w, "volume\t€%.2f apostados  €%.2f pagos  €%.2f para a casa\n", bets.TotalBet, bets.TotalPaid, bets.HouseProfit)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+var statsStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func statsFrame(conn string, at time.Duration, id, status string) blazego.SessionFrame {
+	return blazego.SessionFrame{
+		Time:         statsStart.Add(at),
+		ConnectionID: conn,
+		Room:         "crash_room_4",
+		Event:        "crash.tick",
+		Data:         fmt.Sprintf(`42["data",{"id":"crash.tick","payload":{"id":%q,"status":%q}}]`, id, status),
+	}
+}
+
+func writeFrames(t *testing.T, path string, frames ...blazego.SessionFrame) {
+	t.Helper()
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	for _, frame := range frames {
+		if err := encoder.Encode(frame); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoadSessions(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "crash.jsonl")
+	other := filepath.Join(dir, "other.jsonl")
+
+	// o segmento rotacionado de crash.jsonl e uma segunda gravação com horários intercalados
+	writeFrames(t, filepath.Join(dir, "crash-20240101T000000.000000000.jsonl"), statsFrame("a", 0, "c1", "waiting"), statsFrame("a", 2*time.Second, "c1", "graphing"))
+	writeFrames(t, path, statsFrame("a", 4*time.Second, "c1", "complete"))
+	writeFrames(t, other, statsFrame("b", time.Second, "c1", "waiting"), statsFrame("b", 3*time.Second, "c1", "graphing"))
+
+	frames, err := loadSessions([]string{path, other})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(frames) != 5 {
+		t.Fatalf("got %d frames, want 5", len(frames))
+	}
+	for i, frame := range frames {
+		if want := statsStart.Add(time.Duration(i) * time.Second); !frame.Time.Equal(want) {
+			t.Errorf("frame %d at %v, want %v", i, frame.Time, want)
+		}
+	}
+}
+
+func TestComputeGameStatsGaps(t *testing.T) {
+	frames := []blazego.SessionFrame{
+		statsFrame("a", 0, "c1", "waiting"),
+		statsFrame("a", time.Second, "c1", "graphing"),
+		// reconexão sem tempo perdido
+		statsFrame("b", 1500*time.Millisecond, "c1", "complete"),
+		// fluxo parado por 3 minutos na mesma conexão
+		statsFrame("b", 3*time.Minute, "c2", "waiting"),
+		// reconexão depois de 2 minutos
+		statsFrame("c", 5*time.Minute, "c2", "graphing"),
+		// frame atrasado de uma conexão já vista
+		statsFrame("b", 5*time.Minute+time.Second, "c2", "complete"),
+	}
+
+	stats := computeGameStats("crash", frames, time.Minute)
+
+	if stats.Connections != 3 || stats.Reconnects != 2 {
+		t.Errorf("connections = %d, reconnects = %d, want 3 and 2", stats.Connections, stats.Reconnects)
+	}
+	if len(stats.Gaps) != 2 {
+		t.Fatalf("gaps = %+v, want 2", stats.Gaps)
+	}
+	if gap := stats.Gaps[0]; gap.Reconnect || gap.Duration != 3*time.Minute-1500*time.Millisecond {
+		t.Errorf("first gap = %+v", gap)
+	}
+	if gap := stats.Gaps[1]; !gap.Reconnect || gap.Duration != 2*time.Minute {
+		t.Errorf("second gap = %+v", gap)
+	}
+}
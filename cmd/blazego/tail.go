@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+const (
+	ansiReset   = "\033[0m"
+	ansiBold    = "\033[1m"
+	ansiDim     = "\033[2m"
+	ansiRed     = "\033[31m"
+	ansiGreen   = "\033[32m"
+	ansiYellow  = "\033[33m"
+	ansiCyan    = "\033[36m"
+	ansiOnRed   = "\033[97;41m"
+	ansiOnBlack = "\033[97;40m"
+	ansiOnWhite = "\033[30;107m"
+)
+
+func runTail(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("tail", flag.ContinueOnError)
+	var connFlags connectionFlags
+	connFlags.register(fs)
+	var output outputFlags
+	output.register(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Uso: blazego tail [flags]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	conn, err := connFlags.connection()
+	if err != nil {
+		return err
+	}
+
+	return tail(ctx, conn, output.events(conn), output.printer(connFlags.game).print)
+}
+
+// outputFlags controla quais eventos são impressos e em qual formato
+type outputFlags struct {
+	json    bool
+	bets    bool
+	noColor bool
+}
+
+func (f *outputFlags) register(fs *flag.FlagSet) {
+	fs.BoolVar(&f.json, "json", false, "imprime cada evento como uma linha JSON")
+	fs.BoolVar(&f.bets, "bets", false, "inclui os eventos crash.tick-bets")
+	fs.BoolVar(&f.noColor, "no-color", os.Getenv("NO_COLOR") != "", "desativa as cores ANSI")
+}
+
+// events retorna os eventos acompanhados para a conexão
+func (f *outputFlags) events(conn blazego.Connection) []string {
+	if conn.Web == "blaze-chat" {
+		return []string{"chat.message"}
+	}
+
+	events := []string{"crash.tick", "double.tick"}
+	if f.bets {
+		events = append(events, "crash.tick-bets")
+	}
+	return events
+}
+
+func (f *outputFlags) printer(game string) *tailPrinter {
+	return &tailPrinter{
+		out:   stdout,
+		game:  game,
+		json:  f.json,
+		color: !f.noColor,
+	}
+}
+
+// tail conecta e repassa os eventos informados até o contexto ser cancelado ou a conexão fechar.
+// O fechamento normal (código 1000), como o fim de uma reprodução, não é tratado como erro.
+func tail(ctx context.Context, conn blazego.Connection, events []string, handle func(event string, data interface{})) error {
+	conn, start := blazego.DeferReplayStart(conn)
+	socket, err := blazego.MakeConnection(conn)
+	if err != nil {
+		return err
+	}
+	defer socket.Disconnect()
+
+	for _, event := range events {
+		socket.On(event, func(data interface{}) {
+			handle(event, data)
+		})
+	}
+
+	socket.On("error", func(data interface{}) {
+		handle("error", data)
+	})
+
+	closed := make(chan blazego.CloseEvent, 1)
+	socket.On("close", func(data interface{}) {
+		closeEvent, _ := data.(blazego.CloseEvent)
+		select {
+		case closed <- closeEvent:
+		default:
+		}
+	})
+	start()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case closeEvent := <-closed:
+		if closeEvent.Code == 1000 {
+			return nil
+		}
+		return fmt.Errorf("connection closed with code %d", closeEvent.Code)
+	}
+}
+
+// tailPrinter formata os eventos para o terminal ou como JSON por linha
+type tailPrinter struct {
+	mu    sync.Mutex
+	out   io.Writer
+	game  string
+	json  bool
+	color bool
+}
+
+type tailLine struct {
+	Time  time.Time   `json:"time"`
+	Game  string      `json:"game"`
+	Event string      `json:"event"`
+	Data  interface{} `json:"data"`
+}
+
+func (p *tailPrinter) print(event string, data interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+
+	if p.json {
+		if err, ok := data.(error); ok {
+			data = err.Error()
+		}
+		line, err := json.Marshal(tailLine{Time: now, Game: p.game, Event: event, Data: data})
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(p.out, "%s\n", line)
+		return
+	}
+
+	text := p.format(event, data)
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(p.out, "%s %s\n", p.paint(ansiDim, now.Format("15:04:05")), text)
+}
+
+func (p *tailPrinter) format(event string, data interface{}) string {
+	switch event {
+	case "crash.tick":
+		tick, err := blazego.DecodeEvent[blazego.CrashTickEvent](data)
+		if err != nil {
+			return ""
+		}
+		return p.formatCrash(tick)
+
+	case "crash.tick-bets":
+		bets, err := blazego.DecodeEvent[blazego.CrashTickBetsEvent](data)
+		if err != nil {
+			return ""
+		}
+		return fmt.Sprintf("%s %s apostas: %s jogadores, €%.2f apostados, €%.2f pagos",
+			p.game, bets.ID, bets.TotalBetsPlaced, bets.TotalEurBet, bets.TotalEurWon)
+
+	case "double.tick":
+		tick, err := blazego.DecodeEvent[blazego.DoubleTickEvent](data)
+		if err != nil {
+			return ""
+		}
+		return p.formatDouble(tick)
+
+	case "chat.message":
+		message, err := blazego.DecodeEvent[blazego.ChatMessageEvent](data)
+		if err != nil {
+			return ""
+		}
+		return fmt.Sprintf("%s %s %s", p.paint(ansiDim, "["+message.User.Rank+"]"),
+			p.paint(ansiCyan, message.User.Username+":"), message.Text)
+
+	case "error":
+		return p.paint(ansiRed, fmt.Sprintf("erro: %v", data))
+	}
+
+	return ""
+}
+
+func (p *tailPrinter) formatCrash(tick blazego.CrashTickEvent) string {
+	switch tick.Status {
+	case "complete":
+		if tick.CrashPoint == nil {
+			return fmt.Sprintf("%s %s crash", p.game, tick.ID)
+		}
+
+		point := float64(*tick.CrashPoint)
+		color := ansiRed
+		if point >= 2 {
+			color = ansiGreen
+		}
+		bonus := ""
+		if tick.IsBonusRound {
+			bonus = p.paint(ansiYellow, " bônus")
+		}
+		return fmt.Sprintf("%s %s crash %s%s", p.game, tick.ID, p.paint(ansiBold+color, strconv.FormatFloat(point, 'f', 2, 64)+"x"), bonus)
+
+	case "waiting":
+		return fmt.Sprintf("%s %s aguardando apostas", p.game, tick.ID)
+
+	default:
+		return fmt.Sprintf("%s %s %s", p.game, tick.ID, tick.Status)
+	}
+}
+
+func (p *tailPrinter) formatDouble(tick blazego.DoubleTickEvent) string {
+	totals := fmt.Sprintf("vermelho €%.2f (%d) · preto €%.2f (%d) · branco €%.2f (%d)",
+		tick.TotalRedEurBet, tick.TotalRedBetsPlaced,
+		tick.TotalBlackEurBet, tick.TotalBlackBetsPlaced,
+		tick.TotalWhiteEurBet, tick.TotalWhiteBetsPlaced)
+
+	switch tick.Status {
+	case blazego.DoubleStatusComplete:
+		roll, color, err := tick.Result()
+		if err != nil {
+			return p.paint(ansiRed, fmt.Sprintf("double %s resultado inválido: %v", tick.ID, err))
+		}
+
+		background := ansiOnWhite
+		switch color {
+		case blazego.DoubleColorRed:
+			background = ansiOnRed
+		case blazego.DoubleColorBlack:
+			background = ansiOnBlack
+		}
+		return fmt.Sprintf("double %s %s %s", tick.ID, p.paint(background, fmt.Sprintf(" %2d ", roll)), color)
+
+	case blazego.DoubleStatusWaiting:
+		return fmt.Sprintf("double %s aguardando apostas", tick.ID)
+
+	default:
+		return fmt.Sprintf("double %s %s %s", tick.ID, tick.Status, p.paint(ansiDim, totals))
+	}
+}
+
+func (p *tailPrinter) paint(code, text string) string {
+	if !p.color {
+		return text
+	}
+	return code + text + ansiReset
+}
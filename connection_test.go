@@ -0,0 +1,447 @@
+package blazego_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/blazetest"
+)
+
+const (
+	testTimeout = 5 * time.Second
+	// scriptDelay dá tempo para os callbacks serem registrados depois do MakeConnection
+	scriptDelay = 100 * time.Millisecond
+)
+
+func newTestServer(t *testing.T, script ...[]blazetest.Event) *blazetest.Server {
+	t.Helper()
+
+	events := blazetest.Script(script...)
+	if len(events) > 0 {
+		events[0].Delay += scriptDelay
+	}
+
+	server := blazetest.NewServer(blazetest.Options{Script: events})
+	t.Cleanup(server.Close)
+	return server
+}
+
+func testConnection(server *blazetest.Server, game string) blazego.Connection {
+	url := server.URL()
+	conn := blazego.Connection{URL: &url, Web: "blaze", GameType: game}
+	if game == "chat" {
+		conn.Web = "blaze-chat"
+		conn.GameType = ""
+	}
+	return conn
+}
+
+func crashTicks(id string, point float64) []blazego.CrashTickEvent {
+	crashPoint := blazego.Float64String(point)
+	return []blazego.CrashTickEvent{
+		{ID: id, Status: "waiting"},
+		{ID: id, Status: "graphing"},
+		{ID: id, Status: "complete", CrashPoint: &crashPoint},
+	}
+}
+
+func crashRound(game, id string, point float64) []blazetest.Event {
+	return blazetest.CrashTicks(game, 0, crashTicks(id, point)...)
+}
+
+func doubleTicks(id string, roll int) []blazego.DoubleTickEvent {
+	value := blazego.StringOrNumber(strconv.Itoa(roll))
+	return []blazego.DoubleTickEvent{
+		{ID: id, Status: blazego.DoubleStatusWaiting},
+		{ID: id, Status: blazego.DoubleStatusRolling, TotalRedEurBet: 10, TotalRedBetsPlaced: 2, TotalBlackEurBet: 5, TotalBlackBetsPlaced: 1},
+		{ID: id, Status: blazego.DoubleStatusComplete, Roll: &value, TotalRedEurBet: 10, TotalRedBetsPlaced: 2, TotalBlackEurBet: 5, TotalBlackBetsPlaced: 1},
+	}
+}
+
+func doubleRound(id string, roll int) []blazetest.Event {
+	return blazetest.DoubleTicks(0, doubleTicks(id, roll)...)
+}
+
+// collector guarda os dados recebidos por um callback
+type collector struct {
+	mu   sync.Mutex
+	data []interface{}
+	more chan struct{}
+}
+
+func newCollector() *collector {
+	return &collector{more: make(chan struct{}, 1)}
+}
+
+func (c *collector) add(data interface{}) {
+	c.mu.Lock()
+	c.data = append(c.data, data)
+	c.mu.Unlock()
+
+	select {
+	case c.more <- struct{}{}:
+	default:
+	}
+}
+
+// wait aguarda até n itens serem recebidos
+func (c *collector) wait(t *testing.T, n int) []interface{} {
+	t.Helper()
+
+	deadline := time.After(testTimeout)
+	for {
+		c.mu.Lock()
+		if len(c.data) >= n {
+			data := append([]interface{}{}, c.data...)
+			c.mu.Unlock()
+			return data
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-c.more:
+		case <-deadline:
+			t.Fatalf("received %d of %d items", len(c.data), n)
+		}
+	}
+}
+
+func TestMakeConnection(t *testing.T) {
+	tests := []struct {
+		game   string
+		room   string
+		event  string
+		script []blazetest.Event
+	}{
+		{"crash", "crash_room_4", "crash.tick", crashRound("crash", "c1", 2)},
+		{"crash_2", "crash_room_1", "crash.tick", crashRound("crash_2", "c1", 2)},
+		{"crash_neymarjr", "crash_room_3", "crash.tick", crashRound("crash_neymarjr", "c1", 2)},
+		{"doubles", "double_room_1", "double.tick", doubleRound("d1", 4)},
+		{"chat", blazego.ChatRoom, "chat.message", blazetest.ChatMessages(0,
+			blazego.ChatMessageEvent{ID: "m1", Text: "oi"},
+			blazego.ChatMessageEvent{ID: "m2", Text: "tchau"},
+			blazego.ChatMessageEvent{ID: "m3", Text: "!"},
+		)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.game, func(t *testing.T) {
+			server := newTestServer(t, test.script)
+
+			socket, err := blazego.MakeConnection(testConnection(server, test.game))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer socket.Disconnect()
+
+			events := newCollector()
+			socket.On(test.event, events.add)
+
+			command, ok := server.WaitCommand("subscribe", testTimeout)
+			if !ok {
+				t.Fatal("no subscribe command")
+			}
+			if want := `{"room":"` + test.room + `"}`; string(command.Payload) != want {
+				t.Errorf("subscribe payload = %s, want %s", command.Payload, want)
+			}
+
+			events.wait(t, len(test.script))
+		})
+	}
+}
+
+func TestMakeConnectionDedupe(t *testing.T) {
+	tests := []struct {
+		name  string
+		cache bool
+		want  int
+	}{
+		{"cache", true, 2},
+		{"no cache", false, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			waiting := blazego.CrashTickEvent{ID: "c1", Status: "waiting"}
+			graphing := blazego.CrashTickEvent{ID: "c1", Status: "graphing"}
+			// o último evento marca o fim do roteiro nos dois casos
+			last := blazego.CrashTickEvent{ID: "end", Status: "waiting"}
+			server := newTestServer(t, blazetest.CrashTicks("crash", 0, waiting, waiting, graphing, graphing, last))
+
+			conn := testConnection(server, "crash")
+			conn.CacheIgnoreRepeatedEvents = &test.cache
+			socket, err := blazego.MakeConnection(conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer socket.Disconnect()
+
+			ticks := newCollector()
+			socket.On("crash.tick", ticks.add)
+
+			received := ticks.wait(t, test.want+1)
+			if len(received) != test.want+1 {
+				t.Fatalf("got %d ticks, want %d", len(received), test.want+1)
+			}
+			tick, _ := blazego.DecodeEvent[blazego.CrashTickEvent](received[test.want])
+			if tick.ID != "end" {
+				t.Errorf("tick %d = %s, want end", test.want, tick.ID)
+			}
+		})
+	}
+}
+
+func TestGetNextRound(t *testing.T) {
+	tests := []struct {
+		game   string
+		script []blazetest.Event
+		check  func(t *testing.T, round blazego.Round)
+	}{
+		{
+			game: "crash",
+			// a primeira rodada já está em andamento e deve ser ignorada
+			script: blazetest.Script(crashRound("crash", "c1", 3)[1:], crashRound("crash", "c2", 1.5)),
+			check: func(t *testing.T, round blazego.Round) {
+				point, err := round.CrashPoint()
+				if err != nil || point != 1.5 || round.ID != "c2" || len(round.CrashTicks) != 3 {
+					t.Errorf("round = %+v, %v, %v", round, point, err)
+				}
+			},
+		},
+		{
+			game:   "doubles",
+			script: blazetest.Script(doubleRound("d1", 0)[2:], doubleRound("d2", 11)),
+			check: func(t *testing.T, round blazego.Round) {
+				roll, color, err := round.DoubleResult()
+				if err != nil || roll != 11 || color != blazego.DoubleColorBlack || round.ID != "d2" {
+					t.Errorf("round = %+v, %v, %v, %v", round, roll, color, err)
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.game, func(t *testing.T) {
+			server := newTestServer(t, test.script)
+
+			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+			defer cancel()
+
+			round, err := blazego.GetNextConnectionRound(ctx, testConnection(server, test.game))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if round.Game != test.game {
+				t.Errorf("game = %s, want %s", round.Game, test.game)
+			}
+			test.check(t, round)
+		})
+	}
+}
+
+func TestCollectRounds(t *testing.T) {
+	server := newTestServer(t,
+		crashRound("crash", "c1", 1.2),
+		crashRound("crash", "c2", 4),
+		crashRound("crash", "c3", 1),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	rounds, err := blazego.CollectConnectionRounds(ctx, testConnection(server, "crash"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []float64{1.2, 4, 1}
+	for i, round := range rounds {
+		if point, _ := round.CrashPoint(); point != want[i] {
+			t.Errorf("round %d crash point = %v, want %v", i, point, want[i])
+		}
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := newTestServer(t,
+		crashRound("crash", "c1", 1.2),
+		crashRound("crash", "c2", 4),
+	)
+
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	recorder, err := blazego.NewSessionRecorder(blazego.RecorderOptions{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn := testConnection(server, "crash")
+	conn.Recorder = recorder
+	socket, err := blazego.MakeConnection(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ticks := newCollector()
+	socket.On("crash.tick", ticks.add)
+	ticks.wait(t, 6)
+	socket.Disconnect()
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		speed float64
+	}{
+		{"max speed", 0},
+		{"fast", 50},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+			defer cancel()
+
+			rounds, errs := blazego.StreamConnectionRounds(ctx, blazego.Connection{
+				Web:      "blaze",
+				GameType: "crash",
+				Replay:   &blazego.ReplayOptions{Path: path, Speed: test.speed},
+			})
+
+			points := []float64{}
+			for round := range rounds {
+				point, _ := round.CrashPoint()
+				points = append(points, point)
+			}
+			// a reprodução termina com o código 1000
+			if err := <-errs; err == nil || !strings.Contains(err.Error(), "1000") {
+				t.Errorf("err = %v", err)
+			}
+
+			if len(points) != 2 || points[0] != 1.2 || points[1] != 4 {
+				t.Errorf("points = %v", points)
+			}
+		})
+	}
+}
+
+func TestReconnectHandlers(t *testing.T) {
+	server := newTestServer(t)
+	metrics := blazego.NewMetrics()
+
+	url := server.URL()
+	game := "crash"
+	reconnect := true
+	socket := blazego.NewBlazeSocket(blazego.NewNodeConnectionSocket(), true)
+
+	ticks := newCollector()
+	socket.On("crash.tick", ticks.add)
+
+	if err := socket.Connect(blazego.SocketOptions{URL: &url, Type: &game, Reconnect: &reconnect, Metrics: metrics}); err != nil {
+		t.Fatal(err)
+	}
+	defer socket.Disconnect()
+
+	// duas quedas seguidas de reconexão
+	for subscribes := 1; subscribes <= 3; subscribes++ {
+		deadline := time.Now().Add(testTimeout)
+		for countCommands(server, "subscribe") < subscribes {
+			if time.Now().After(deadline) {
+				t.Fatalf("got %d subscribes, want %d", countCommands(server, "subscribe"), subscribes)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if subscribes < 3 {
+			server.CloseConnections()
+		}
+	}
+
+	for _, event := range crashRound("crash", "c1", 2) {
+		server.Broadcast(event)
+	}
+	ticks.wait(t, 3)
+	time.Sleep(scriptDelay)
+
+	if received := len(ticks.wait(t, 0)); received != 3 {
+		t.Errorf("received %d crash.tick, want 3", received)
+	}
+
+	var output strings.Builder
+	metrics.Write(&output)
+	for _, want := range []string{
+		`blazego_events_total{game="crash",event="crash.tick"} 3`,
+		`blazego_reconnects_total{game="crash"} 2`,
+		`blazego_connected{game="crash"} 1`,
+	} {
+		if !strings.Contains(output.String(), want+"\n") {
+			t.Errorf("metrics without %s", want)
+		}
+	}
+	if strings.Contains(output.String(), "blazego_dedupe_hits_total{") {
+		t.Error("events counted as dedupe hits")
+	}
+}
+
+func TestFaultInjection(t *testing.T) {
+	events := blazetest.Script(crashRound("crash", "c1", 2), crashRound("crash", "c2", 3))
+	events[0].Delay += scriptDelay
+
+	// cada evento chega depois de um frame truncado e a conexão cai no quarto evento
+	server := blazetest.NewServer(blazetest.Options{
+		Script: events,
+		Faults: blazetest.Faults{MalformedRate: 1, CloseAfter: 4, Seed: 1},
+	})
+	defer server.Close()
+	metrics := blazego.NewMetrics()
+
+	url := server.URL()
+	game := "crash"
+	reconnect := true
+	socket := blazego.NewBlazeSocket(blazego.NewNodeConnectionSocket(), true)
+
+	ticks := newCollector()
+	socket.On("crash.tick", ticks.add)
+
+	if err := socket.Connect(blazego.SocketOptions{URL: &url, Type: &game, Reconnect: &reconnect, Metrics: metrics}); err != nil {
+		t.Fatal(err)
+	}
+	defer socket.Disconnect()
+
+	// a segunda conexão repete o script: o c1 volta a ser emitido porque o status mudou e o c2 cai no dedupe
+	received := ticks.wait(t, 7)
+	for i, want := range []string{"c1 waiting", "c1 graphing", "c1 complete", "c2 waiting", "c1 waiting", "c1 graphing", "c1 complete"} {
+		tick := received[i].(map[string]interface{})
+		if got := fmt.Sprint(tick["id"], " ", tick["status"]); got != want {
+			t.Errorf("tick %d = %s, want %s", i, got, want)
+		}
+	}
+
+	var output strings.Builder
+	metrics.Write(&output)
+	for _, want := range []string{
+		`blazego_parse_failures_total{game="crash"`,
+		`blazego_reconnects_total{game="crash"}`,
+		`blazego_dedupe_hits_total{game="crash"}`,
+	} {
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("metrics without %s", want)
+		}
+	}
+}
+
+func countCommands(server *blazetest.Server, id string) int {
+	count := 0
+	for _, command := range server.Commands() {
+		if command.ID == id {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,228 @@
+package blazego
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/viniciusgdr/blazego/internal/emitter"
+)
+
+// CashOutBucket representa uma faixa de multiplicadores de saída [Min, Max)
+type CashOutBucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"` // 0 indica faixa sem limite superior
+	Count int     `json:"count"`
+}
+
+var cashOutBucketLimits = []float64{1, 1.5, 2, 3, 5, 10}
+
+// CrashBetsSummary representa o resumo das apostas de uma rodada do crash
+type CrashBetsSummary struct {
+	Game          string          `json:"game"`
+	ID            string          `json:"id"`
+	CrashPoint    float64         `json:"crash_point"`
+	Players       int             `json:"players"`
+	CashedOut     int             `json:"cashed_out"`
+	TotalBet      float64         `json:"total_bet"`
+	TotalWon      float64         `json:"total_won"`
+	HouseProfit   float64         `json:"house_profit"`
+	MeanCashOut   float64         `json:"mean_cash_out"`
+	MedianCashOut float64         `json:"median_cash_out"`
+	CashOuts      []CashOutBucket `json:"cash_outs"`
+	// Whales são as maiores apostas da rodada, da maior para a menor
+	Whales      []Bet     `json:"whales"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// CrashBetsAggregator consolida os eventos crash.tick-bets de cada rodada e emite um
+// resumo quando a rodada termina no crash.tick. Um crash.tick-bets da rodada finalizada que chega
+// depois do complete atualiza o resumo. Eventos emitidos: "summary" (CrashBetsSummary) e
+// "updated" (CrashBetsSummary já emitido, recalculado com as apostas finais).
+type CrashBetsAggregator struct {
+	mu        sync.Mutex
+	game      string
+	topN      int
+	events    *emitter.Emitter
+	snapshots map[string]CrashTickBetsEvent
+	summaries *ringBuffer[CrashBetsSummary]
+	// lastTick é o complete da última rodada finalizada e summarized indica se ela já tem resumo
+	lastTick   CrashTickEvent
+	summarized bool
+}
+
+// NewCrashBetsAggregator cria o agregador mantendo as topN maiores apostas e os últimos capacity resumos
+func NewCrashBetsAggregator(game string, topN, capacity int) *CrashBetsAggregator {
+	return &CrashBetsAggregator{
+		game:      game,
+		topN:      topN,
+		events:    emitter.New(),
+		snapshots: make(map[string]CrashTickBetsEvent),
+		summaries: newRingBuffer[CrashBetsSummary](capacity),
+	}
+}
+
+// Attach registra o agregador nos eventos crash.tick-bets e crash.tick da conexão
+func (a *CrashBetsAggregator) Attach(conn ConnectionSocketResponses) {
+	conn.On("crash.tick-bets", func(data interface{}) {
+		betsEvent, err := DecodeEvent[CrashTickBetsEvent](data)
+		if err != nil {
+			return
+		}
+		a.HandleBets(betsEvent)
+	})
+
+	conn.On("crash.tick", func(data interface{}) {
+		tickEvent, err := DecodeEvent[CrashTickEvent](data)
+		if err != nil {
+			return
+		}
+		a.HandleTick(tickEvent)
+	})
+}
+
+// HandleBets guarda o estado mais recente das apostas da rodada. Para a rodada que acabou de
+// terminar, retorna o resumo criado ou atualizado com essas apostas.
+func (a *CrashBetsAggregator) HandleBets(event CrashTickBetsEvent) (CrashBetsSummary, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if event.ID == "" {
+		return CrashBetsSummary{}, false
+	}
+
+	if event.ID != a.lastTick.ID {
+		a.snapshots[event.ID] = event
+		return CrashBetsSummary{}, false
+	}
+
+	summary := a.summarize(event)
+	if !a.summarized {
+		a.summarized = true
+		a.summaries.push(summary)
+		a.events.Emit("summary", summary)
+		return summary, true
+	}
+
+	last := a.summaries.len() - 1
+	summary.CompletedAt = a.summaries.at(last).CompletedAt
+	a.summaries.set(last, summary)
+	a.events.Emit("updated", summary)
+
+	return summary, true
+}
+
+// HandleTick finaliza a rodada quando o status for "complete", retornando o resumo
+func (a *CrashBetsAggregator) HandleTick(event CrashTickEvent) (CrashBetsSummary, bool) {
+	if event.Status != "complete" {
+		return CrashBetsSummary{}, false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if event.ID == "" || event.ID == a.lastTick.ID {
+		return CrashBetsSummary{}, false
+	}
+
+	snapshot, exists := a.snapshots[event.ID]
+
+	// descarta também rodadas antigas que nunca terminaram
+	clear(a.snapshots)
+	a.lastTick = event
+	a.summarized = exists
+
+	if !exists {
+		return CrashBetsSummary{}, false
+	}
+
+	summary := a.summarize(snapshot)
+	a.summaries.push(summary)
+	a.events.Emit("summary", summary)
+
+	return summary, true
+}
+
+// summarize calcula o resumo da última rodada finalizada; quem chama segura a.mu
+func (a *CrashBetsAggregator) summarize(snapshot CrashTickBetsEvent) CrashBetsSummary {
+	summary := NewCrashBetsSummary(snapshot, a.topN)
+	summary.Game = a.game
+	if a.lastTick.CrashPoint != nil {
+		summary.CrashPoint = float64(*a.lastTick.CrashPoint)
+	}
+	return summary
+}
+
+// Last retorna os n resumos mais recentes em ordem cronológica
+func (a *CrashBetsAggregator) Last(n int) []CrashBetsSummary {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.summaries.last(n)
+}
+
+func (a *CrashBetsAggregator) On(event string, callback func(data interface{})) {
+	a.events.On(event, callback)
+}
+
+// NewCrashBetsSummary calcula o resumo a partir do último crash.tick-bets de uma rodada
+func NewCrashBetsSummary(event CrashTickBetsEvent, topN int) CrashBetsSummary {
+	summary := CrashBetsSummary{
+		ID:          event.ID,
+		Players:     len(event.Bets),
+		TotalBet:    event.TotalEurBet,
+		TotalWon:    event.TotalEurWon,
+		HouseProfit: event.TotalEurBet - event.TotalEurWon,
+		CashOuts:    make([]CashOutBucket, len(cashOutBucketLimits)),
+		Whales:      []Bet{},
+		CompletedAt: time.Now(),
+	}
+
+	if placed, err := strconv.Atoi(event.TotalBetsPlaced); err == nil && placed > summary.Players {
+		summary.Players = placed
+	}
+
+	for i, limit := range cashOutBucketLimits {
+		summary.CashOuts[i].Min = limit
+		if i+1 < len(cashOutBucketLimits) {
+			summary.CashOuts[i].Max = cashOutBucketLimits[i+1]
+		}
+	}
+
+	cashOuts := []float64{}
+	for _, bet := range event.Bets {
+		if bet.CashedOutAt == nil {
+			continue
+		}
+
+		cashOut := *bet.CashedOutAt
+		cashOuts = append(cashOuts, cashOut)
+		for i := len(summary.CashOuts) - 1; i >= 0; i-- {
+			if cashOut >= summary.CashOuts[i].Min {
+				summary.CashOuts[i].Count++
+				break
+			}
+		}
+	}
+
+	summary.CashedOut = len(cashOuts)
+	if len(cashOuts) > 0 {
+		slices.Sort(cashOuts)
+		sum := 0.0
+		for _, cashOut := range cashOuts {
+			sum += cashOut
+		}
+		summary.MeanCashOut = sum / float64(len(cashOuts))
+		summary.MedianCashOut = percentile(cashOuts, 50)
+	}
+
+	whales := slices.Clone(event.Bets)
+	slices.SortStableFunc(whales, func(a, b Bet) int {
+		return cmp.Compare(b.Amount, a.Amount)
+	})
+	summary.Whales = append(summary.Whales, whales[:min(max(topN, 0), len(whales))]...)
+
+	return summary
+}
@@ -0,0 +1,103 @@
+package blazego_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/sim"
+)
+
+func TestCrashBetsAggregatorOrder(t *testing.T) {
+	point := blazego.Float64String(2)
+	complete := blazego.CrashTickEvent{ID: "c1", Status: "complete", CrashPoint: &point}
+	early := blazego.CrashTickBetsEvent{ID: "c1", TotalEurBet: 10, Bets: []blazego.Bet{{ID: "b1", Amount: 10}}}
+	final := blazego.CrashTickBetsEvent{ID: "c1", TotalEurBet: 30, TotalEurWon: 5, Bets: []blazego.Bet{{ID: "b1", Amount: 10}, {ID: "b2", Amount: 20}}}
+	next := blazego.CrashTickBetsEvent{ID: "c2", TotalEurBet: 99}
+
+	tests := []struct {
+		name string
+		// events contém CrashTickEvent ou CrashTickBetsEvent, na ordem de chegada
+		events  []interface{}
+		emitted []string
+		total   float64
+	}{
+		{"bets before complete", []interface{}{early, final, complete}, []string{"summary"}, 30},
+		{"final bets after complete", []interface{}{early, complete, final}, []string{"summary", "updated"}, 30},
+		{"only bets after complete", []interface{}{complete, final}, []string{"summary"}, 30},
+		{"next round bets", []interface{}{early, complete, next}, []string{"summary"}, 10},
+		{"repeated complete", []interface{}{early, complete, complete}, []string{"summary"}, 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aggregator := blazego.NewCrashBetsAggregator("crash_2", 1, 10)
+
+			emitted := []string{}
+			for _, event := range test.events {
+				var ok bool
+				var summary blazego.CrashBetsSummary
+				switch event := event.(type) {
+				case blazego.CrashTickEvent:
+					summary, ok = aggregator.HandleTick(event)
+					if ok {
+						emitted = append(emitted, "summary")
+					}
+				case blazego.CrashTickBetsEvent:
+					previous := len(aggregator.Last(-1))
+					summary, ok = aggregator.HandleBets(event)
+					if ok && len(aggregator.Last(-1)) == previous {
+						emitted = append(emitted, "updated")
+					} else if ok {
+						emitted = append(emitted, "summary")
+					}
+				}
+				if ok && summary.CrashPoint != 2 {
+					t.Errorf("crash point = %v", summary.CrashPoint)
+				}
+			}
+
+			if len(emitted) != len(test.emitted) {
+				t.Fatalf("emitted = %v, want %v", emitted, test.emitted)
+			}
+			for i := range emitted {
+				if emitted[i] != test.emitted[i] {
+					t.Fatalf("emitted = %v, want %v", emitted, test.emitted)
+				}
+			}
+
+			summaries := aggregator.Last(-1)
+			if len(summaries) != 1 || summaries[0].ID != "c1" || summaries[0].TotalBet != test.total {
+				t.Errorf("summaries = %+v", summaries)
+			}
+		})
+	}
+}
+
+func TestCrashBetsFromFrames(t *testing.T) {
+	simulator := sim.NewCrashSimulator(sim.CrashOptions{Game: "crash_2", Players: 20, Seed: 3})
+	ticks := simulator.Rounds(20)
+
+	frames, err := sim.Frames("crash_2", time.Now(), ticks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// o simulador envia o último crash.tick-bets depois do complete
+	final := make(map[string]blazego.CrashTickBetsEvent)
+	for _, tick := range ticks {
+		if bets, ok := tick.Payload.(blazego.CrashTickBetsEvent); ok {
+			final[bets.ID] = bets
+		}
+	}
+
+	summaries := blazego.CrashBetsFromFrames(frames, "crash_2", 3)
+	if len(summaries) != len(final) {
+		t.Fatalf("got %d summaries, want %d", len(summaries), len(final))
+	}
+	for _, summary := range summaries {
+		if bets := final[summary.ID]; summary.TotalWon != bets.TotalEurWon || summary.TotalBet != bets.TotalEurBet {
+			t.Errorf("summary %s = %v/%v, want final bets %v/%v", summary.ID, summary.TotalBet, summary.TotalWon, bets.TotalEurBet, bets.TotalEurWon)
+		}
+	}
+}
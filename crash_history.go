@@ -0,0 +1,242 @@
+package blazego
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// CrashResult representa o resultado de uma rodada finalizada do crash
+type CrashResult struct {
+	Game         string    `json:"game"`
+	ID           string    `json:"id"`
+	CrashPoint   float64   `json:"crash_point"`
+	IsBonusRound bool      `json:"is_bonus_round"`
+	UpdatedAt    string    `json:"updated_at"`
+	CompletedAt  time.Time `json:"completed_at"`
+}
+
+// CrashStats representa estatísticas calculadas sobre os resultados do histórico
+type CrashStats struct {
+	Count       int     `json:"count"`
+	BonusRounds int     `json:"bonus_rounds"`
+	Min         float64 `json:"min"`
+	Max         float64 `json:"max"`
+	Mean        float64 `json:"mean"`
+	Median      float64 `json:"median"`
+	P90         float64 `json:"p90"`
+	P99         float64 `json:"p99"`
+}
+
+// CrashHistory mantém em memória as últimas rodadas finalizadas de um jogo do crash
+type CrashHistory struct {
+	mu      sync.RWMutex
+	game    string
+	results *ringBuffer[CrashResult]
+	ids     map[string]struct{}
+}
+
+func NewCrashHistory(game string, capacity int) *CrashHistory {
+	return &CrashHistory{
+		game:    game,
+		results: newRingBuffer[CrashResult](capacity),
+		ids:     make(map[string]struct{}),
+	}
+}
+
+// Attach alimenta o histórico com os eventos crash.tick finalizados da conexão
+func (h *CrashHistory) Attach(conn ConnectionSocketResponses) {
+	conn.On("crash.tick", func(data interface{}) {
+		tickEvent, err := DecodeEvent[CrashTickEvent](data)
+		if err != nil {
+			return
+		}
+		h.Handle(tickEvent)
+	})
+}
+
+// Handle registra o tick caso ele represente uma rodada finalizada.
+// Retorna true quando um novo resultado foi adicionado.
+func (h *CrashHistory) Handle(event CrashTickEvent) bool {
+	if event.Status != "complete" || event.CrashPoint == nil {
+		return false
+	}
+
+	return h.Add(CrashResult{
+		Game:         h.game,
+		ID:           event.ID,
+		CrashPoint:   float64(*event.CrashPoint),
+		IsBonusRound: event.IsBonusRound,
+		UpdatedAt:    event.UpdatedAt,
+		CompletedAt:  time.Now(),
+	})
+}
+
+// AddRound registra o resultado de uma rodada coletada pelo StreamRounds
+func (h *CrashHistory) AddRound(round Round) error {
+	if len(round.CrashTicks) == 0 {
+		return errors.New("round has no crash ticks")
+	}
+
+	last := round.CrashTicks[len(round.CrashTicks)-1]
+	if last.Status != "complete" || last.CrashPoint == nil {
+		return errors.New("round is not complete")
+	}
+
+	h.Add(CrashResult{
+		Game:         h.game,
+		ID:           round.ID,
+		CrashPoint:   float64(*last.CrashPoint),
+		IsBonusRound: last.IsBonusRound,
+		UpdatedAt:    last.UpdatedAt,
+		CompletedAt:  round.CompletedAt,
+	})
+	return nil
+}
+
+// Add adiciona um resultado ignorando rodadas já registradas
+func (h *CrashHistory) Add(result CrashResult) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, exists := h.ids[result.ID]; exists {
+		return false
+	}
+
+	if evicted, ok := h.results.push(result); ok {
+		delete(h.ids, evicted.ID)
+	}
+	h.ids[result.ID] = struct{}{}
+
+	return true
+}
+
+func (h *CrashHistory) Game() string {
+	return h.game
+}
+
+func (h *CrashHistory) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.results.len()
+}
+
+// Last retorna os n resultados mais recentes em ordem cronológica
+func (h *CrashHistory) Last(n int) []CrashResult {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.results.last(n)
+}
+
+// Since retorna os resultados finalizados a partir do horário informado
+func (h *CrashHistory) Since(t time.Time) []CrashResult {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	result := []CrashResult{}
+	for i := range h.results.len() {
+		item := h.results.at(i)
+		if !item.CompletedAt.Before(t) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// StreakUnder retorna quantas rodadas seguidas, a partir da mais recente, terminaram abaixo do multiplicador
+func (h *CrashHistory) StreakUnder(multiplier float64) int {
+	return h.streak(func(result CrashResult) bool {
+		return result.CrashPoint < multiplier
+	})
+}
+
+// StreakOver retorna quantas rodadas seguidas, a partir da mais recente, atingiram o multiplicador
+func (h *CrashHistory) StreakOver(multiplier float64) int {
+	return h.streak(func(result CrashResult) bool {
+		return result.CrashPoint >= multiplier
+	})
+}
+
+func (h *CrashHistory) streak(match func(result CrashResult) bool) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	count := 0
+	for i := h.results.len() - 1; i >= 0; i-- {
+		if !match(h.results.at(i)) {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// Percentile retorna o multiplicador no percentil p (0-100) usando o método nearest-rank
+func (h *CrashHistory) Percentile(p float64) (float64, bool) {
+	points := h.sortedPoints()
+	if len(points) == 0 {
+		return 0, false
+	}
+	return percentile(points, p), true
+}
+
+// Stats retorna estatísticas de todos os resultados do histórico
+func (h *CrashHistory) Stats() CrashStats {
+	return NewCrashStats(h.Last(-1))
+}
+
+func (h *CrashHistory) sortedPoints() []float64 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	points := make([]float64, h.results.len())
+	for i := range points {
+		points[i] = h.results.at(i).CrashPoint
+	}
+	slices.Sort(points)
+	return points
+}
+
+// NewCrashStats calcula estatísticas sobre uma lista de resultados
+func NewCrashStats(results []CrashResult) CrashStats {
+	stats := CrashStats{Count: len(results)}
+	if len(results) == 0 {
+		return stats
+	}
+
+	points := make([]float64, len(results))
+	sum := 0.0
+	for i, result := range results {
+		points[i] = result.CrashPoint
+		sum += result.CrashPoint
+		if result.IsBonusRound {
+			stats.BonusRounds++
+		}
+	}
+	slices.Sort(points)
+
+	stats.Min = points[0]
+	stats.Max = points[len(points)-1]
+	stats.Mean = sum / float64(len(points))
+	stats.Median = percentile(points, 50)
+	stats.P90 = percentile(points, 90)
+	stats.P99 = percentile(points, 99)
+
+	return stats
+}
+
+func percentile(sorted []float64, p float64) float64 {
+	if p <= 0 {
+		return sorted[0]
+	}
+	if p >= 100 {
+		return sorted[len(sorted)-1]
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[rank-1]
+}
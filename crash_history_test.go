@@ -0,0 +1,126 @@
+package blazego_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// crashHistory cria o histórico com uma rodada por minuto a partir de start
+func crashHistory(capacity int, start time.Time, points ...float64) *blazego.CrashHistory {
+	history := blazego.NewCrashHistory("crash", capacity)
+	for i, point := range points {
+		history.Add(blazego.CrashResult{
+			Game:        "crash",
+			ID:          fmt.Sprintf("c%d", i),
+			CrashPoint:  point,
+			CompletedAt: start.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	return history
+}
+
+func crashIDs(results []blazego.CrashResult) []string {
+	ids := []string{}
+	for _, result := range results {
+		ids = append(ids, result.ID)
+	}
+	return ids
+}
+
+func TestCrashHistoryBuffer(t *testing.T) {
+	start := time.Now()
+	history := crashHistory(3, start, 1.5, 2, 3, 4, 5)
+
+	if history.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", history.Len())
+	}
+	if ids := crashIDs(history.Last(10)); !slices.Equal(ids, []string{"c2", "c3", "c4"}) {
+		t.Errorf("Last(10) = %v", ids)
+	}
+	if ids := crashIDs(history.Last(2)); !slices.Equal(ids, []string{"c3", "c4"}) {
+		t.Errorf("Last(2) = %v", ids)
+	}
+
+	// rodadas ainda no buffer são ignoradas, as que já saíram podem voltar
+	if history.Add(blazego.CrashResult{ID: "c4", CrashPoint: 2}) {
+		t.Error("duplicated round added")
+	}
+	if !history.Add(blazego.CrashResult{ID: "c0", CrashPoint: 2}) {
+		t.Error("evicted round not added")
+	}
+}
+
+func TestCrashHistorySince(t *testing.T) {
+	start := time.Now()
+
+	tests := []struct {
+		name    string
+		history *blazego.CrashHistory
+		since   time.Time
+		want    []string
+	}{
+		{"empty", crashHistory(10, start), start, []string{}},
+		{"all", crashHistory(10, start, 1, 2, 3), start.Add(-time.Hour), []string{"c0", "c1", "c2"}},
+		{"inclusive", crashHistory(10, start, 1, 2, 3), start.Add(time.Minute), []string{"c1", "c2"}},
+		{"future", crashHistory(10, start, 1, 2, 3), start.Add(time.Hour), []string{}},
+		{"evicted", crashHistory(2, start, 1, 2, 3), start, []string{"c1", "c2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := test.history.Since(test.since)
+			if results == nil {
+				t.Fatal("Since() = nil")
+			}
+			if ids := crashIDs(results); !slices.Equal(ids, test.want) {
+				t.Errorf("Since() = %v, want %v", ids, test.want)
+			}
+		})
+	}
+}
+
+func TestCrashHistoryPercentile(t *testing.T) {
+	history := crashHistory(10, time.Now(), 10, 9, 8, 7, 6, 5, 4, 3, 2, 1)
+
+	for _, test := range []struct{ p, want float64 }{
+		{0, 1}, {10, 1}, {50, 5}, {90, 9}, {95, 10}, {100, 10}, {150, 10}, {-5, 1},
+	} {
+		if got, ok := history.Percentile(test.p); !ok || got != test.want {
+			t.Errorf("Percentile(%v) = %v, %v, want %v", test.p, got, ok, test.want)
+		}
+	}
+
+	if _, ok := crashHistory(10, time.Now()).Percentile(50); ok {
+		t.Error("Percentile() of an empty history returned ok")
+	}
+}
+
+func TestCrashHistoryStreaks(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []float64
+		over   int
+		under  int
+	}{
+		{"empty", nil, 0, 0},
+		{"over", []float64{1.5, 3, 2, 2.5}, 3, 0},
+		{"under", []float64{3, 1.2, 1.9}, 0, 2},
+		{"all under", []float64{1, 1.5, 1.99}, 0, 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history := crashHistory(10, time.Now(), test.points...)
+			if got := history.StreakOver(2); got != test.over {
+				t.Errorf("StreakOver(2) = %d, want %d", got, test.over)
+			}
+			if got := history.StreakUnder(2); got != test.under {
+				t.Errorf("StreakUnder(2) = %d, want %d", got, test.under)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package blazego
+
+import (
+	"sync"
+	"time"
+
+	"github.com/viniciusgdr/blazego/internal/emitter"
+)
+
+// DoubleColorFlow representa o dinheiro apostado em uma cor
+type DoubleColorFlow struct {
+	Amount      float64 `json:"amount"`
+	Bets        int     `json:"bets"`
+	AmountShare float64 `json:"amount_share"`
+	CountShare  float64 `json:"count_share"`
+	// Skew é a diferença entre a fatia do dinheiro e a fatia das apostas; positivo indica apostas maiores que a média
+	Skew float64 `json:"skew"`
+	// AmountChange e BetsChange comparam o início do "rolling" com o primeiro tick "waiting" acompanhado
+	AmountChange float64 `json:"amount_change"`
+	BetsChange   int     `json:"bets_change"`
+}
+
+// DoubleBetsSummary representa o sentimento das apostas de uma rodada do double.
+// Color, Roll, Payout e HouseProfit só são válidos quando Complete for true.
+type DoubleBetsSummary struct {
+	ID          string          `json:"id"`
+	Red         DoubleColorFlow `json:"red"`
+	Black       DoubleColorFlow `json:"black"`
+	White       DoubleColorFlow `json:"white"`
+	TotalAmount float64         `json:"total_amount"`
+	TotalBets   int             `json:"total_bets"`
+	Complete    bool            `json:"complete"`
+	Color       DoubleColor     `json:"color"`
+	Roll        int             `json:"roll"`
+	Payout      float64         `json:"payout"`
+	HouseProfit float64         `json:"house_profit"`
+	RollingAt   time.Time       `json:"rolling_at"`
+	CompletedAt time.Time       `json:"completed_at,omitzero"`
+}
+
+// Flow retorna o fluxo de apostas da cor informada
+func (s DoubleBetsSummary) Flow(color DoubleColor) DoubleColorFlow {
+	switch color {
+	case DoubleColorRed:
+		return s.Red
+	case DoubleColorBlack:
+		return s.Black
+	default:
+		return s.White
+	}
+}
+
+// DoubleBetsAggregator acompanha os totais por cor dos eventos double.tick.
+// Eventos emitidos: "rolling" (DoubleBetsSummary sem resultado) e "complete" (DoubleBetsSummary com resultado).
+type DoubleBetsAggregator struct {
+	mu        sync.Mutex
+	events    *emitter.Emitter
+	first     map[string]DoubleTickEvent
+	pending   map[string]DoubleBetsSummary
+	summaries *ringBuffer[DoubleBetsSummary]
+	lastID    string
+}
+
+// NewDoubleBetsAggregator cria o agregador mantendo os últimos capacity resumos finalizados
+func NewDoubleBetsAggregator(capacity int) *DoubleBetsAggregator {
+	return &DoubleBetsAggregator{
+		events:    emitter.New(),
+		first:     make(map[string]DoubleTickEvent),
+		pending:   make(map[string]DoubleBetsSummary),
+		summaries: newRingBuffer[DoubleBetsSummary](capacity),
+	}
+}
+
+// Attach registra o agregador nos eventos double.tick da conexão
+func (a *DoubleBetsAggregator) Attach(conn ConnectionSocketResponses) {
+	conn.On("double.tick", func(data interface{}) {
+		tickEvent, err := DecodeEvent[DoubleTickEvent](data)
+		if err != nil {
+			return
+		}
+		a.Handle(tickEvent)
+	})
+}
+
+// Handle processa um tick do double, retornando o resumo quando a rodada for finalizada
+func (a *DoubleBetsAggregator) Handle(event DoubleTickEvent) (DoubleBetsSummary, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if event.ID == "" || event.ID == a.lastID {
+		return DoubleBetsSummary{}, false
+	}
+
+	switch event.Status {
+	case DoubleStatusWaiting:
+		if _, exists := a.first[event.ID]; !exists {
+			a.first[event.ID] = event
+		}
+
+	case DoubleStatusRolling:
+		if _, exists := a.pending[event.ID]; exists {
+			break
+		}
+
+		summary := a.summarize(event)
+		a.pending[event.ID] = summary
+		a.events.Emit("rolling", summary)
+
+	case DoubleStatusComplete:
+		roll, color, err := doubleResult(event)
+		if err != nil {
+			break
+		}
+
+		summary, exists := a.pending[event.ID]
+		if !exists {
+			summary = a.summarize(event)
+		}
+
+		summary.Complete = true
+		summary.Roll = roll
+		summary.Color = color
+		summary.Payout = summary.Flow(color).Amount * color.Payout()
+		summary.HouseProfit = summary.TotalAmount - summary.Payout
+		summary.CompletedAt = time.Now()
+
+		// descarta também rodadas antigas que nunca terminaram
+		clear(a.first)
+		clear(a.pending)
+		a.lastID = event.ID
+
+		a.summaries.push(summary)
+		a.events.Emit("complete", summary)
+
+		return summary, true
+	}
+
+	return DoubleBetsSummary{}, false
+}
+
+// Last retorna os n resumos finalizados mais recentes em ordem cronológica
+func (a *DoubleBetsAggregator) Last(n int) []DoubleBetsSummary {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.summaries.last(n)
+}
+
+func (a *DoubleBetsAggregator) On(event string, callback func(data interface{})) {
+	a.events.On(event, callback)
+}
+
+func (a *DoubleBetsAggregator) summarize(event DoubleTickEvent) DoubleBetsSummary {
+	summary := NewDoubleBetsSummary(event)
+
+	if first, exists := a.first[event.ID]; exists {
+		summary.Red.AmountChange = event.TotalRedEurBet - first.TotalRedEurBet
+		summary.Red.BetsChange = event.TotalRedBetsPlaced - first.TotalRedBetsPlaced
+		summary.Black.AmountChange = event.TotalBlackEurBet - first.TotalBlackEurBet
+		summary.Black.BetsChange = event.TotalBlackBetsPlaced - first.TotalBlackBetsPlaced
+		summary.White.AmountChange = event.TotalWhiteEurBet - first.TotalWhiteEurBet
+		summary.White.BetsChange = event.TotalWhiteBetsPlaced - first.TotalWhiteBetsPlaced
+	}
+
+	return summary
+}
+
+// NewDoubleBetsSummary calcula a divisão do dinheiro e das apostas entre as cores de um tick
+func NewDoubleBetsSummary(event DoubleTickEvent) DoubleBetsSummary {
+	summary := DoubleBetsSummary{
+		ID:          event.ID,
+		Red:         DoubleColorFlow{Amount: event.TotalRedEurBet, Bets: event.TotalRedBetsPlaced},
+		Black:       DoubleColorFlow{Amount: event.TotalBlackEurBet, Bets: event.TotalBlackBetsPlaced},
+		White:       DoubleColorFlow{Amount: event.TotalWhiteEurBet, Bets: event.TotalWhiteBetsPlaced},
+		TotalAmount: event.TotalRedEurBet + event.TotalBlackEurBet + event.TotalWhiteEurBet,
+		TotalBets:   event.TotalRedBetsPlaced + event.TotalBlackBetsPlaced + event.TotalWhiteBetsPlaced,
+		RollingAt:   time.Now(),
+	}
+
+	for _, flow := range []*DoubleColorFlow{&summary.Red, &summary.Black, &summary.White} {
+		if summary.TotalAmount > 0 {
+			flow.AmountShare = flow.Amount / summary.TotalAmount
+		}
+		if summary.TotalBets > 0 {
+			flow.CountShare = float64(flow.Bets) / float64(summary.TotalBets)
+		}
+		flow.Skew = flow.AmountShare - flow.CountShare
+	}
+
+	return summary
+}
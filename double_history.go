@@ -0,0 +1,290 @@
+package blazego
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// DoubleStreak representa a sequência atual de rodadas da mesma cor
+type DoubleStreak struct {
+	Color  DoubleColor `json:"color"`
+	Length int         `json:"length"`
+}
+
+// DoubleStats representa as estatísticas de cores e números de um conjunto de rodadas do double
+type DoubleStats struct {
+	Count  int          `json:"count"`
+	Red    int          `json:"red"`
+	Black  int          `json:"black"`
+	White  int          `json:"white"`
+	Rolls  [15]int      `json:"rolls"`
+	Streak DoubleStreak `json:"streak"`
+	// SinceWhite é a quantidade de rodadas desde o último branco acompanhado, mesmo que ele já tenha
+	// saído do buffer ou da janela (-1 se nenhum branco foi acompanhado)
+	SinceWhite int `json:"since_white"`
+}
+
+// Frequency retorna a proporção de rodadas da cor informada
+func (s DoubleStats) Frequency(color DoubleColor) float64 {
+	if s.Count == 0 {
+		return 0
+	}
+
+	switch color {
+	case DoubleColorRed:
+		return float64(s.Red) / float64(s.Count)
+	case DoubleColorBlack:
+		return float64(s.Black) / float64(s.Count)
+	case DoubleColorWhite:
+		return float64(s.White) / float64(s.Count)
+	default:
+		return 0
+	}
+}
+
+type doubleCounts struct {
+	colors [3]int
+	rolls  [15]int
+}
+
+func (c *doubleCounts) add(round DoubleRound, delta int) {
+	c.colors[round.Color] += delta
+	c.rolls[round.Roll] += delta
+}
+
+// DoubleHistory mantém em memória as últimas rodadas finalizadas do double.
+// As contagens gerais e das janelas registradas em NewDoubleHistory são atualizadas a cada rodada.
+type DoubleHistory struct {
+	mu         sync.RWMutex
+	rounds     *ringBuffer[DoubleRound]
+	ids        map[string]struct{}
+	counts     doubleCounts
+	windows    map[int]*doubleCounts
+	streak     DoubleStreak
+	sinceWhite int
+}
+
+func NewDoubleHistory(capacity int, windows ...int) *DoubleHistory {
+	history := &DoubleHistory{
+		rounds:     newRingBuffer[DoubleRound](capacity),
+		ids:        make(map[string]struct{}),
+		windows:    make(map[int]*doubleCounts),
+		sinceWhite: -1,
+	}
+
+	for _, window := range windows {
+		if window > 0 && window <= history.rounds.capacity() {
+			history.windows[window] = &doubleCounts{}
+		}
+	}
+
+	return history
+}
+
+// Attach alimenta o histórico com os eventos double.tick finalizados da conexão
+func (h *DoubleHistory) Attach(conn ConnectionSocketResponses) {
+	conn.On("double.tick", func(data interface{}) {
+		tickEvent, err := DecodeEvent[DoubleTickEvent](data)
+		if err != nil {
+			return
+		}
+		h.Handle(tickEvent)
+	})
+}
+
+// Handle registra o tick caso ele represente uma rodada finalizada.
+// Retorna true quando um novo resultado foi adicionado.
+func (h *DoubleHistory) Handle(event DoubleTickEvent) bool {
+	if event.Status != DoubleStatusComplete {
+		return false
+	}
+
+	roll, color, err := doubleResult(event)
+	if err != nil {
+		return false
+	}
+
+	return h.Add(DoubleRound{
+		ID:          event.ID,
+		Status:      event.Status,
+		Color:       color,
+		Roll:        roll,
+		CreatedAt:   event.CreatedAt,
+		UpdatedAt:   event.UpdatedAt,
+		CompletedAt: time.Now(),
+	})
+}
+
+// AddRound registra o resultado de uma rodada coletada pelo StreamRounds
+func (h *DoubleHistory) AddRound(round Round) error {
+	if len(round.DoubleTicks) == 0 {
+		return errors.New("round has no double ticks")
+	}
+
+	last := round.DoubleTicks[len(round.DoubleTicks)-1]
+	if last.Status != DoubleStatusComplete {
+		return errors.New("round is not complete")
+	}
+
+	roll, color, err := doubleResult(last)
+	if err != nil {
+		return err
+	}
+
+	h.Add(DoubleRound{
+		ID:          round.ID,
+		Status:      last.Status,
+		Color:       color,
+		Roll:        roll,
+		CreatedAt:   last.CreatedAt,
+		UpdatedAt:   last.UpdatedAt,
+		StartedAt:   round.StartedAt,
+		CompletedAt: round.CompletedAt,
+	})
+	return nil
+}
+
+// Add adiciona uma rodada finalizada ignorando rodadas já registradas
+func (h *DoubleHistory) Add(round DoubleRound) bool {
+	if color, err := DoubleColorFromRoll(round.Roll); err != nil || color != round.Color {
+		return false
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, exists := h.ids[round.ID]; exists {
+		return false
+	}
+
+	for window, counts := range h.windows {
+		if h.rounds.len() >= window {
+			counts.add(h.rounds.at(h.rounds.len()-window), -1)
+		}
+		counts.add(round, 1)
+	}
+
+	if evicted, ok := h.rounds.push(round); ok {
+		delete(h.ids, evicted.ID)
+		h.counts.add(evicted, -1)
+	}
+	h.ids[round.ID] = struct{}{}
+	h.counts.add(round, 1)
+
+	if h.streak.Length > 0 && h.streak.Color == round.Color {
+		h.streak.Length++
+	} else {
+		h.streak = DoubleStreak{Color: round.Color, Length: 1}
+	}
+
+	if round.Color == DoubleColorWhite {
+		h.sinceWhite = 0
+	} else if h.sinceWhite >= 0 {
+		h.sinceWhite++
+	}
+
+	return true
+}
+
+func (h *DoubleHistory) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.rounds.len()
+}
+
+// Last retorna as n rodadas mais recentes em ordem cronológica
+func (h *DoubleHistory) Last(n int) []DoubleRound {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.rounds.last(n)
+}
+
+// Since retorna as rodadas finalizadas a partir do horário informado
+func (h *DoubleHistory) Since(t time.Time) []DoubleRound {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	result := []DoubleRound{}
+	for i := range h.rounds.len() {
+		round := h.rounds.at(i)
+		if !round.CompletedAt.Before(t) {
+			result = append(result, round)
+		}
+	}
+	return result
+}
+
+// Streak retorna a sequência atual de rodadas da mesma cor
+func (h *DoubleHistory) Streak() DoubleStreak {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.streak
+}
+
+// SinceWhite retorna a quantidade de rodadas desde o último branco acompanhado (-1 se não houve branco)
+func (h *DoubleHistory) SinceWhite() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.sinceWhite
+}
+
+// Stats retorna as estatísticas de todas as rodadas do histórico
+func (h *DoubleHistory) Stats() DoubleStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.stats(h.counts, h.rounds.len())
+}
+
+// Window retorna as estatísticas das últimas n rodadas.
+// Janelas registradas em NewDoubleHistory são mantidas incrementalmente, as demais são calculadas na hora.
+func (h *DoubleHistory) Window(n int) DoubleStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if n > h.rounds.len() || n < 0 {
+		n = h.rounds.len()
+	}
+
+	if counts, exists := h.windows[n]; exists {
+		return h.stats(*counts, n)
+	}
+
+	var counts doubleCounts
+	for _, round := range h.rounds.last(n) {
+		counts.add(round, 1)
+	}
+	return h.stats(counts, n)
+}
+
+func (h *DoubleHistory) stats(counts doubleCounts, size int) DoubleStats {
+	stats := DoubleStats{
+		Count:      size,
+		White:      counts.colors[DoubleColorWhite],
+		Red:        counts.colors[DoubleColorRed],
+		Black:      counts.colors[DoubleColorBlack],
+		Rolls:      counts.rolls,
+		Streak:     h.streak,
+		SinceWhite: h.sinceWhite,
+	}
+
+	if stats.Streak.Length > size {
+		stats.Streak.Length = size
+	}
+
+	return stats
+}
+
+// NewDoubleStats calcula as estatísticas de uma lista de rodadas em ordem cronológica
+func NewDoubleStats(rounds []DoubleRound) DoubleStats {
+	history := NewDoubleHistory(len(rounds))
+	for _, round := range rounds {
+		history.Add(round)
+	}
+	return history.Stats()
+}
@@ -0,0 +1,106 @@
+package blazego_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// doubleHistory cria o histórico com uma rodada por minuto a partir de start
+func doubleHistory(capacity int, windows []int, start time.Time, rolls ...int) *blazego.DoubleHistory {
+	history := blazego.NewDoubleHistory(capacity, windows...)
+	for i, roll := range rolls {
+		color, _ := blazego.DoubleColorFromRoll(roll)
+		history.Add(blazego.DoubleRound{
+			ID:          fmt.Sprintf("d%d", i),
+			Color:       color,
+			Roll:        roll,
+			CompletedAt: start.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	return history
+}
+
+func TestDoubleHistorySince(t *testing.T) {
+	start := time.Now()
+
+	tests := []struct {
+		name    string
+		history *blazego.DoubleHistory
+		since   time.Time
+		want    []string
+	}{
+		{"empty", doubleHistory(10, nil, start), start, []string{}},
+		{"inclusive", doubleHistory(10, nil, start, 1, 8, 0), start.Add(time.Minute), []string{"d1", "d2"}},
+		{"future", doubleHistory(10, nil, start, 1, 8, 0), start.Add(time.Hour), []string{}},
+		{"evicted", doubleHistory(2, nil, start, 1, 8, 0), start, []string{"d1", "d2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids := []string{}
+			for _, round := range test.history.Since(test.since) {
+				ids = append(ids, round.ID)
+			}
+			if !slices.Equal(ids, test.want) {
+				t.Errorf("Since() = %v, want %v", ids, test.want)
+			}
+		})
+	}
+}
+
+func TestDoubleHistoryWindow(t *testing.T) {
+	start := time.Now()
+	// vermelho, vermelho, branco, preto, preto, preto
+	rolls := []int{1, 7, 0, 8, 14, 9}
+
+	tests := []struct {
+		name    string
+		history *blazego.DoubleHistory
+		window  int
+		want    blazego.DoubleStats
+	}{
+		{"empty", doubleHistory(5, nil, start), 3,
+			blazego.DoubleStats{SinceWhite: -1}},
+		{"registered", doubleHistory(10, []int{4}, start, rolls...), 4,
+			blazego.DoubleStats{Count: 4, Black: 3, White: 1, Streak: blazego.DoubleStreak{Color: blazego.DoubleColorBlack, Length: 3}, SinceWhite: 3}},
+		{"computed", doubleHistory(10, nil, start, rolls...), 4,
+			blazego.DoubleStats{Count: 4, Black: 3, White: 1, Streak: blazego.DoubleStreak{Color: blazego.DoubleColorBlack, Length: 3}, SinceWhite: 3}},
+		{"streak longer than window", doubleHistory(10, []int{2}, start, rolls...), 2,
+			blazego.DoubleStats{Count: 2, Black: 2, Streak: blazego.DoubleStreak{Color: blazego.DoubleColorBlack, Length: 2}, SinceWhite: 3}},
+		// janelas maiores que a capacidade não são registradas e usam as rodadas do buffer
+		{"larger than capacity", doubleHistory(3, []int{10}, start, rolls...), 10,
+			blazego.DoubleStats{Count: 3, Black: 3, Streak: blazego.DoubleStreak{Color: blazego.DoubleColorBlack, Length: 3}, SinceWhite: 3}},
+		{"negative", doubleHistory(10, nil, start, rolls...), -1,
+			blazego.DoubleStats{Count: 6, Red: 2, Black: 3, White: 1, Streak: blazego.DoubleStreak{Color: blazego.DoubleColorBlack, Length: 3}, SinceWhite: 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats := test.history.Window(test.window)
+			stats.Rolls = [15]int{}
+			if stats != test.want {
+				t.Errorf("Window(%d) = %+v, want %+v", test.window, stats, test.want)
+			}
+		})
+	}
+}
+
+func TestDoubleHistoryWindowRolls(t *testing.T) {
+	history := doubleHistory(4, []int{2}, time.Now(), 1, 7, 0, 8, 14, 8)
+
+	want := [15]int{}
+	want[14], want[8] = 1, 1
+	if rolls := history.Window(2).Rolls; rolls != want {
+		t.Errorf("Window(2).Rolls = %v, want %v", rolls, want)
+	}
+
+	want = [15]int{}
+	want[0], want[8], want[14] = 1, 2, 1
+	if rolls := history.Stats().Rolls; rolls != want {
+		t.Errorf("Stats().Rolls = %v, want %v", rolls, want)
+	}
+}
@@ -0,0 +1,298 @@
+package blazego
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/viniciusgdr/blazego/internal/emitter"
+)
+
+const (
+	DoubleStatusWaiting  = "waiting"
+	DoubleStatusRolling  = "rolling"
+	DoubleStatusComplete = "complete"
+)
+
+// DoubleColor representa a cor sorteada em uma rodada do double
+type DoubleColor int
+
+const (
+	DoubleColorWhite DoubleColor = 0
+	DoubleColorRed   DoubleColor = 1
+	DoubleColorBlack DoubleColor = 2
+)
+
+func (c DoubleColor) String() string {
+	switch c {
+	case DoubleColorWhite:
+		return "white"
+	case DoubleColorRed:
+		return "red"
+	case DoubleColorBlack:
+		return "black"
+	default:
+		return fmt.Sprintf("DoubleColor(%d)", int(c))
+	}
+}
+
+// Payout retorna o multiplicador pago para uma aposta vencedora na cor (2x vermelho/preto, 14x branco)
+func (c DoubleColor) Payout() float64 {
+	if c == DoubleColorWhite {
+		return 14
+	}
+	return 2
+}
+
+func (c DoubleColor) MarshalText() ([]byte, error) {
+	switch c {
+	case DoubleColorWhite, DoubleColorRed, DoubleColorBlack:
+		return []byte(c.String()), nil
+	default:
+		return nil, fmt.Errorf("invalid double color %d", int(c))
+	}
+}
+
+func (c *DoubleColor) UnmarshalText(text []byte) error {
+	color, err := ParseDoubleColor(string(text))
+	if err != nil {
+		return err
+	}
+	*c = color
+	return nil
+}
+
+// ParseDoubleColor aceita tanto o código numérico enviado pela Blaze ("0", "1", "2")
+// quanto o nome da cor ("white", "red", "black")
+func ParseDoubleColor(value string) (DoubleColor, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "0", "white":
+		return DoubleColorWhite, nil
+	case "1", "red":
+		return DoubleColorRed, nil
+	case "2", "black":
+		return DoubleColorBlack, nil
+	default:
+		return 0, fmt.Errorf("invalid double color %q", value)
+	}
+}
+
+// DoubleColorFromRoll retorna a cor correspondente ao número sorteado (0 branco, 1-7 vermelho, 8-14 preto)
+func DoubleColorFromRoll(roll int) (DoubleColor, error) {
+	switch {
+	case roll == 0:
+		return DoubleColorWhite, nil
+	case roll >= 1 && roll <= 7:
+		return DoubleColorRed, nil
+	case roll >= 8 && roll <= 14:
+		return DoubleColorBlack, nil
+	default:
+		return 0, fmt.Errorf("invalid double roll %d", roll)
+	}
+}
+
+// DoubleBetSnapshot representa o estado das apostas no momento em que a rodada começou a girar
+type DoubleBetSnapshot struct {
+	TotalRedEurBet       float64   `json:"total_red_eur_bet"`
+	TotalRedBetsPlaced   int       `json:"total_red_bets_placed"`
+	TotalWhiteEurBet     float64   `json:"total_white_eur_bet"`
+	TotalWhiteBetsPlaced int       `json:"total_white_bets_placed"`
+	TotalBlackEurBet     float64   `json:"total_black_eur_bet"`
+	TotalBlackBetsPlaced int       `json:"total_black_bets_placed"`
+	Bets                 []Bet     `json:"bets"`
+	TakenAt              time.Time `json:"taken_at"`
+}
+
+// DoubleRound representa uma rodada do double acompanhada pelo DoubleRoundTracker.
+// Color e Roll só são válidos quando Status for "complete".
+type DoubleRound struct {
+	ID          string             `json:"id"`
+	Status      string             `json:"status"`
+	Color       DoubleColor        `json:"color"`
+	Roll        int                `json:"roll"`
+	CreatedAt   string             `json:"created_at"`
+	UpdatedAt   string             `json:"updated_at"`
+	Snapshot    *DoubleBetSnapshot `json:"snapshot,omitempty"`
+	StartedAt   time.Time          `json:"started_at"`
+	CompletedAt time.Time          `json:"completed_at,omitzero"`
+}
+
+func (r DoubleRound) Complete() bool {
+	return r.Status == DoubleStatusComplete
+}
+
+// DoubleRoundTracker acompanha as fases das rodadas do double a partir dos eventos double.tick.
+// Eventos emitidos: "waiting", "rolling" e "complete" (DoubleRound), "abandoned" (DoubleRound que
+// não terminou antes de outra rodada começar, por exemplo depois de uma reconexão) e "error" (error).
+type DoubleRoundTracker struct {
+	mu      sync.Mutex
+	events  *emitter.Emitter
+	current *DoubleRound
+	last    *DoubleRound
+}
+
+func NewDoubleRoundTracker() *DoubleRoundTracker {
+	return &DoubleRoundTracker{
+		events: emitter.New(),
+	}
+}
+
+// Attach registra o tracker nos eventos double.tick da conexão
+func (t *DoubleRoundTracker) Attach(conn ConnectionSocketResponses) {
+	conn.On("double.tick", func(data interface{}) {
+		tickEvent, err := DecodeEvent[DoubleTickEvent](data)
+		if err == nil {
+			err = t.Handle(tickEvent)
+		}
+
+		if err != nil {
+			t.emit("error", err)
+		}
+	})
+}
+
+// Handle processa um tick do double e emite os callbacks da fase quando o status muda
+func (t *DoubleRoundTracker) Handle(event DoubleTickEvent) error {
+	if event.ID == "" {
+		return errors.New("missing round id")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.current == nil || t.current.ID != event.ID {
+		if t.last != nil && t.last.ID == event.ID {
+			return nil
+		}
+
+		if t.current != nil {
+			t.emit("abandoned", *t.current)
+		}
+
+		t.current = &DoubleRound{
+			ID:        event.ID,
+			StartedAt: time.Now(),
+		}
+	}
+
+	round := t.current
+	if round.Status == event.Status {
+		return nil
+	}
+
+	switch event.Status {
+	case DoubleStatusWaiting:
+	case DoubleStatusRolling:
+		round.Snapshot = newDoubleBetSnapshot(event)
+	case DoubleStatusComplete:
+		roll, color, err := doubleResult(event)
+		if err != nil {
+			return err
+		}
+
+		round.Roll = roll
+		round.Color = color
+		round.CompletedAt = time.Now()
+	default:
+		return fmt.Errorf("unknown double status %q", event.Status)
+	}
+
+	round.Status = event.Status
+	round.CreatedAt = event.CreatedAt
+	round.UpdatedAt = event.UpdatedAt
+
+	if round.Complete() {
+		last := *round
+		t.last = &last
+		t.current = nil
+	}
+
+	t.emit(event.Status, *round)
+
+	return nil
+}
+
+// Current retorna a rodada em andamento, se houver
+func (t *DoubleRoundTracker) Current() (DoubleRound, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.current == nil {
+		return DoubleRound{}, false
+	}
+	return *t.current, true
+}
+
+// Last retorna a última rodada finalizada, se houver
+func (t *DoubleRoundTracker) Last() (DoubleRound, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.last == nil {
+		return DoubleRound{}, false
+	}
+	return *t.last, true
+}
+
+func (t *DoubleRoundTracker) On(event string, callback func(data interface{})) {
+	t.events.On(event, callback)
+}
+
+func (t *DoubleRoundTracker) emit(event string, data interface{}) {
+	t.events.Emit(event, data)
+}
+
+func newDoubleBetSnapshot(event DoubleTickEvent) *DoubleBetSnapshot {
+	bets := make([]Bet, len(event.Bets))
+	copy(bets, event.Bets)
+
+	return &DoubleBetSnapshot{
+		TotalRedEurBet:       event.TotalRedEurBet,
+		TotalRedBetsPlaced:   event.TotalRedBetsPlaced,
+		TotalWhiteEurBet:     event.TotalWhiteEurBet,
+		TotalWhiteBetsPlaced: event.TotalWhiteBetsPlaced,
+		TotalBlackEurBet:     event.TotalBlackEurBet,
+		TotalBlackBetsPlaced: event.TotalBlackBetsPlaced,
+		Bets:                 bets,
+		TakenAt:              time.Now(),
+	}
+}
+
+// Result retorna o número sorteado e a cor validados de um tick finalizado
+func (e DoubleTickEvent) Result() (int, DoubleColor, error) {
+	return doubleResult(e)
+}
+
+func doubleResult(event DoubleTickEvent) (int, DoubleColor, error) {
+	if event.Roll == nil {
+		return 0, 0, fmt.Errorf("missing roll for round %s", event.ID)
+	}
+
+	roll, err := strconv.Atoi(string(*event.Roll))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid roll for round %s: %w", event.ID, err)
+	}
+
+	rollColor, err := DoubleColorFromRoll(roll)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if event.Color == nil {
+		return roll, rollColor, nil
+	}
+
+	color, err := ParseDoubleColor(string(*event.Color))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if color != rollColor {
+		return 0, 0, fmt.Errorf("color %s does not match roll %d for round %s", color, roll, event.ID)
+	}
+
+	return roll, color, nil
+}
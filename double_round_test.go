@@ -0,0 +1,129 @@
+package blazego_test
+
+import (
+	"testing"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func doubleTick(id, status, roll, color string) blazego.DoubleTickEvent {
+	event := blazego.DoubleTickEvent{ID: id, Status: status}
+	if roll != "" {
+		value := blazego.StringOrNumber(roll)
+		event.Roll = &value
+	}
+	if color != "" {
+		value := blazego.StringOrNumber(color)
+		event.Color = &value
+	}
+	return event
+}
+
+func TestParseDoubleColor(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    blazego.DoubleColor
+		wantErr bool
+	}{
+		{"0", blazego.DoubleColorWhite, false},
+		{"1", blazego.DoubleColorRed, false},
+		{"2", blazego.DoubleColorBlack, false},
+		{" Black ", blazego.DoubleColorBlack, false},
+		{"white", blazego.DoubleColorWhite, false},
+		{"3", 0, true},
+		{"green", 0, true},
+	}
+
+	for _, test := range tests {
+		color, err := blazego.ParseDoubleColor(test.value)
+		if (err != nil) != test.wantErr || color != test.want {
+			t.Errorf("ParseDoubleColor(%q) = %v, %v", test.value, color, err)
+		}
+	}
+}
+
+func TestDoubleColorFromRoll(t *testing.T) {
+	tests := []struct {
+		roll    int
+		want    blazego.DoubleColor
+		wantErr bool
+	}{
+		{0, blazego.DoubleColorWhite, false},
+		{1, blazego.DoubleColorRed, false},
+		{7, blazego.DoubleColorRed, false},
+		{8, blazego.DoubleColorBlack, false},
+		{14, blazego.DoubleColorBlack, false},
+		{15, 0, true},
+		{-1, 0, true},
+	}
+
+	for _, test := range tests {
+		color, err := blazego.DoubleColorFromRoll(test.roll)
+		if (err != nil) != test.wantErr || color != test.want {
+			t.Errorf("DoubleColorFromRoll(%d) = %v, %v", test.roll, color, err)
+		}
+	}
+}
+
+func TestDoubleRoundTracker(t *testing.T) {
+	tracker := blazego.NewDoubleRoundTracker()
+
+	rolling := doubleTick("d1", "rolling", "", "")
+	rolling.TotalRedEurBet = 10
+	rolling.Bets = []blazego.Bet{{ID: "b1"}}
+
+	// d1 nunca termina e é substituída por d2
+	ticks := []blazego.DoubleTickEvent{
+		doubleTick("d1", "waiting", "", ""),
+		rolling,
+		doubleTick("d2", "waiting", "", ""),
+		doubleTick("d2", "rolling", "", ""),
+		doubleTick("d2", "complete", "9", "2"),
+		// ticks repetidos de uma rodada finalizada são ignorados
+		doubleTick("d2", "complete", "9", "2"),
+	}
+	for _, tick := range ticks {
+		if err := tracker.Handle(tick); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if current, ok := tracker.Current(); ok {
+		t.Errorf("current = %+v", current)
+	}
+
+	last, ok := tracker.Last()
+	if !ok || last.ID != "d2" || last.Roll != 9 || last.Color != blazego.DoubleColorBlack || last.Snapshot == nil || last.CompletedAt.IsZero() {
+		t.Errorf("last = %+v, %v", last, ok)
+	}
+
+	if err := tracker.Handle(rolling); err != nil {
+		t.Fatal(err)
+	}
+	current, ok := tracker.Current()
+	if !ok || current.ID != "d1" || current.Snapshot == nil || current.Snapshot.TotalRedEurBet != 10 || len(current.Snapshot.Bets) != 1 {
+		t.Errorf("current = %+v, %v", current, ok)
+	}
+}
+
+func TestDoubleRoundTrackerErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		tick blazego.DoubleTickEvent
+	}{
+		{"missing id", doubleTick("", "waiting", "", "")},
+		{"unknown status", doubleTick("d1", "paused", "", "")},
+		{"missing roll", doubleTick("d1", "complete", "", "")},
+		{"invalid roll", doubleTick("d1", "complete", "x", "")},
+		{"roll out of range", doubleTick("d1", "complete", "15", "")},
+		{"color mismatch", doubleTick("d1", "complete", "3", "0")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := blazego.NewDoubleRoundTracker().Handle(test.tick); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Package fair implementa a verificação "provably fair" dos resultados da Blaze.
+//
+// Cada rodada possui um server seed que só é revelado depois do resultado. Os seeds
+// formam uma cadeia SHA-256: o seed da rodada anterior é o hash do seed da rodada
+// seguinte, então a partir do seed mais recente é possível recalcular todos os anteriores.
+package fair
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// PreviousSeed retorna o seed da rodada anterior da cadeia
+func PreviousSeed(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(sum[:])
+}
+
+// Chain retorna n seeds a partir do seed informado, do mais recente para o mais antigo
+func Chain(seed string, n int) []string {
+	if n <= 0 {
+		return []string{}
+	}
+
+	seeds := make([]string, n)
+	seeds[0] = seed
+	for i := 1; i < n; i++ {
+		seeds[i] = PreviousSeed(seeds[i-1])
+	}
+	return seeds
+}
+
+// VerifyChain verifica se os seeds (do mais recente para o mais antigo) formam uma cadeia válida
+func VerifyChain(seeds []string) error {
+	for i := 1; i < len(seeds); i++ {
+		if expected := PreviousSeed(seeds[i-1]); seeds[i] != expected {
+			return fmt.Errorf("broken chain at index %d: expected %s, got %s", i, expected, seeds[i])
+		}
+	}
+	return nil
+}
+
+// gameHash retorna o HMAC-SHA256 do salt usando o seed como chave, em hexadecimal
+func gameHash(seed, salt string) string {
+	mac := hmac.New(sha256.New, []byte(seed))
+	mac.Write([]byte(salt))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// reverseSeeds retorna os seeds em ordem cronológica, alinhados com uma lista de n rodadas
+// cujo último item corresponde ao seed informado
+func reverseSeeds(seed string, n int) []string {
+	seeds := Chain(seed, n)
+	for i, j := 0, len(seeds)-1; i < j; i, j = i+1, j-1 {
+		seeds[i], seeds[j] = seeds[j], seeds[i]
+	}
+	return seeds
+}
@@ -0,0 +1,170 @@
+package fair
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// CrashScheme descreve como o ponto do crash é derivado do seed da rodada, seguindo o script
+// de verificação publicado:
+//
+//	hash  = HMAC-SHA256(chave = seed, mensagem = Salt)
+//	se hash (inteiro de 256 bits) for divisível por floor(100 / HouseEdge), a rodada termina em 1.00x
+//	h     = primeiros 52 bits do hash, e = 2^52
+//	point = floor((100*e - h) / (e - h)) / 100
+type CrashScheme struct {
+	// Salt é o valor público (hash de um bloco) definido antes do início da cadeia
+	Salt string
+	// HouseEdge é a margem da casa em porcentagem, aplicada pelas rodadas que terminam
+	// instantaneamente (3 equivale a uma rodada em 33); 0 desabilita
+	HouseEdge float64
+	// BonusMultiplier multiplica o ponto das rodadas bônus do crash_2. Com 0 as rodadas bônus
+	// não são comparadas e entram em CrashReport.Skipped.
+	BonusMultiplier float64
+}
+
+// DefaultCrashHouseEdge é a margem da casa padrão do crash, em porcentagem
+const DefaultCrashHouseEdge = 3.0
+
+// CrashPoint recalcula o multiplicador da rodada a partir do seed
+func CrashPoint(seed string, scheme CrashScheme) float64 {
+	return CrashPointFromHash(gameHash(seed, scheme.Salt), scheme.HouseEdge)
+}
+
+// CrashPointFromHash calcula o multiplicador a partir do hash da rodada em hexadecimal;
+// hashes com menos de 13 dígitos ou inválidos retornam 0
+func CrashPointFromHash(hash string, houseEdge float64) float64 {
+	if len(hash) < 13 {
+		return 0
+	}
+
+	if houseEdge > 0 {
+		if divisor := int64(100 / houseEdge); divisor > 1 && divisible(hash, divisor) {
+			return 1
+		}
+	}
+
+	h, err := strconv.ParseUint(hash[:13], 16, 64)
+	if err != nil {
+		return 0
+	}
+
+	e := math.Pow(2, 52)
+	point := math.Floor((100*e-float64(h))/(e-float64(h))) / 100
+
+	return math.Max(1, point)
+}
+
+// divisible indica se o hash, lido como inteiro, é divisível por mod
+func divisible(hash string, mod int64) bool {
+	value, ok := new(big.Int).SetString(hash, 16)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Mod(value, big.NewInt(mod)).Sign() == 0
+}
+
+// CrashMismatch representa uma rodada cujo resultado gravado difere do recalculado
+type CrashMismatch struct {
+	Index    int     `json:"index"`
+	ID       string  `json:"id"`
+	Seed     string  `json:"seed"`
+	Expected float64 `json:"expected"`
+	Recorded float64 `json:"recorded"`
+}
+
+func (m CrashMismatch) String() string {
+	return fmt.Sprintf("round %s (#%d): expected %.2fx, recorded %.2fx", m.ID, m.Index, m.Expected, m.Recorded)
+}
+
+// CrashReport representa o resultado da verificação de uma lista de rodadas do crash
+type CrashReport struct {
+	Checked int `json:"checked"`
+	// Skipped são as rodadas bônus não comparadas por falta de CrashScheme.BonusMultiplier
+	Skipped    int             `json:"skipped"`
+	Mismatches []CrashMismatch `json:"mismatches"`
+}
+
+func (r CrashReport) OK() bool {
+	return len(r.Mismatches) == 0
+}
+
+// First retorna a primeira rodada divergente em ordem cronológica
+func (r CrashReport) First() (CrashMismatch, bool) {
+	if len(r.Mismatches) == 0 {
+		return CrashMismatch{}, false
+	}
+	return r.Mismatches[0], true
+}
+
+// VerifyCrash verifica os resultados gravados em ordem cronológica usando o seed da
+// rodada mais recente (a última da lista) para recalcular a cadeia inteira.
+// Apenas eventos com status "complete" são considerados.
+func VerifyCrash(seed string, events []blazego.CrashTickEvent, scheme CrashScheme) CrashReport {
+	results := completedCrashResults(events)
+	return VerifyCrashResults(reverseSeeds(seed, len(results)), results, scheme)
+}
+
+// VerifyCrashResults verifica os resultados usando um seed por rodada, ambos em ordem cronológica
+func VerifyCrashResults(seeds []string, results []blazego.CrashResult, scheme CrashScheme) CrashReport {
+	report := CrashReport{Mismatches: []CrashMismatch{}}
+
+	for i, result := range results {
+		if i >= len(seeds) {
+			break
+		}
+
+		expected := CrashPoint(seeds[i], scheme)
+		if result.IsBonusRound {
+			if scheme.BonusMultiplier <= 0 {
+				report.Skipped++
+				continue
+			}
+			expected = math.Round(expected*scheme.BonusMultiplier*100) / 100
+		}
+
+		// um ponto abaixo de 1.00x só aparece nas rodadas que terminam instantaneamente
+		recorded := math.Max(1, result.CrashPoint)
+
+		report.Checked++
+		if math.Round(expected*100) != math.Round(recorded*100) {
+			report.Mismatches = append(report.Mismatches, CrashMismatch{
+				Index:    i,
+				ID:       result.ID,
+				Seed:     seeds[i],
+				Expected: expected,
+				Recorded: result.CrashPoint,
+			})
+		}
+	}
+
+	return report
+}
+
+func completedCrashResults(events []blazego.CrashTickEvent) []blazego.CrashResult {
+	results := []blazego.CrashResult{}
+	seen := make(map[string]struct{})
+
+	for _, event := range events {
+		if event.Status != "complete" || event.CrashPoint == nil {
+			continue
+		}
+		if _, exists := seen[event.ID]; exists {
+			continue
+		}
+		seen[event.ID] = struct{}{}
+
+		results = append(results, blazego.CrashResult{
+			ID:           event.ID,
+			CrashPoint:   float64(*event.CrashPoint),
+			IsBonusRound: event.IsBonusRound,
+			UpdatedAt:    event.UpdatedAt,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,151 @@
+package fair
+
+import (
+	"testing"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+const testSalt = "0000000000000000000fa3b65e43e4240d71762a5bf397d5304b2596d116859c"
+
+// vetores gerados pelo script de verificação publicado (Node.js), a partir da cadeia de
+// sha256("blazego"), com margem de 3%
+var crashVectors = []struct {
+	seed  string
+	point float64
+}{
+	{"177496d5fdb33cad28889a9db0f4715a54074a62d123ca662b3093136b50939b", 1.16},
+	{"150afb4bbdf7ef17076ec6c8131622d3108ec82949b11336276b83f1faa15a54", 2.18},
+	{"2696f20c5c2c4adb02f770de5b7737b20f696fecb772f6030808c30e49d58c99", 2.20},
+	{"ace4b15e95d3a4b29f6fbb9ee4933caf67a0d11dd637ddb3790bb281483fd3ab", 7.09},
+	{"c632a1141e04c29bf65776b07c90a061dbd21e86c5758ebae03d5848cb6afcaf", 1.11},
+	{"c692ad3ef00838e45e943742ab05728383d78bcd27e9431f9d32d1da386f91cf", 1.63},
+	{"6fd508559861e55c2041ffa26908348ec11cb64231cb62f4ca5eec4963fc1ce7", 2.37},
+	{"6da076db692bfcfd11701c280630d148f103c9569e05f211db082cde45b9eb5c", 1.30},
+}
+
+func TestCrashPoint(t *testing.T) {
+	scheme := CrashScheme{Salt: testSalt, HouseEdge: DefaultCrashHouseEdge}
+
+	for _, vector := range crashVectors {
+		if point := CrashPoint(vector.seed, scheme); point != vector.point {
+			t.Errorf("CrashPoint(%s) = %v, want %v", vector.seed[:8], point, vector.point)
+		}
+	}
+}
+
+func TestCrashPointInstantCrash(t *testing.T) {
+	tests := []struct {
+		name      string
+		seed      string
+		houseEdge float64
+		want      float64
+	}{
+		{"divisible by 33", "43f19bbbcbaa420536b98b9b3a81662c7a54c549aa235330b8ffd8497bd536dc", 3, 1},
+		{"divisible by 33 again", "a30096ca9b64ecbb2de250149c56456422fe391de858eb4b4458e2a38d555eef", 3, 1},
+		{"no house edge", "43f19bbbcbaa420536b98b9b3a81662c7a54c549aa235330b8ffd8497bd536dc", 0, 2.76},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			point := CrashPoint(test.seed, CrashScheme{Salt: testSalt, HouseEdge: test.houseEdge})
+			if point != test.want {
+				t.Errorf("CrashPoint() = %v, want %v", point, test.want)
+			}
+		})
+	}
+}
+
+func TestCrashPointFromHashInvalid(t *testing.T) {
+	for _, hash := range []string{"", "3", "43f19bbbcbaa", "zzzzzzzzzzzzzzzz"} {
+		if point := CrashPointFromHash(hash, DefaultCrashHouseEdge); point != 0 {
+			t.Errorf("CrashPointFromHash(%q) = %v, want 0", hash, point)
+		}
+	}
+}
+
+func TestVerifyCrashResults(t *testing.T) {
+	scheme := CrashScheme{Salt: testSalt, HouseEdge: DefaultCrashHouseEdge}
+	seeds := []string{crashVectors[0].seed, crashVectors[1].seed, crashVectors[2].seed, "43f19bbbcbaa420536b98b9b3a81662c7a54c549aa235330b8ffd8497bd536dc"}
+
+	tests := []struct {
+		name       string
+		scheme     CrashScheme
+		results    []blazego.CrashResult
+		checked    int
+		skipped    int
+		mismatches int
+	}{
+		{
+			name:   "all match",
+			scheme: scheme,
+			results: []blazego.CrashResult{
+				{ID: "a", CrashPoint: 1.16}, {ID: "b", CrashPoint: 2.18}, {ID: "c", CrashPoint: 2.2}, {ID: "d", CrashPoint: 1},
+			},
+			checked: 4,
+		},
+		{
+			name:   "instant crash recorded as zero",
+			scheme: scheme,
+			results: []blazego.CrashResult{
+				{ID: "a", CrashPoint: 1.16}, {ID: "b", CrashPoint: 2.18}, {ID: "c", CrashPoint: 2.2}, {ID: "d", CrashPoint: 0},
+			},
+			checked: 4,
+		},
+		{
+			name:   "mismatch",
+			scheme: scheme,
+			results: []blazego.CrashResult{
+				{ID: "a", CrashPoint: 1.16}, {ID: "b", CrashPoint: 3.5},
+			},
+			checked:    2,
+			mismatches: 1,
+		},
+		{
+			name:   "bonus skipped without multiplier",
+			scheme: scheme,
+			results: []blazego.CrashResult{
+				{ID: "a", CrashPoint: 2.32, IsBonusRound: true}, {ID: "b", CrashPoint: 2.18},
+			},
+			checked: 1,
+			skipped: 1,
+		},
+		{
+			name:   "bonus with multiplier",
+			scheme: CrashScheme{Salt: testSalt, HouseEdge: DefaultCrashHouseEdge, BonusMultiplier: 2},
+			results: []blazego.CrashResult{
+				{ID: "a", CrashPoint: 2.32, IsBonusRound: true}, {ID: "b", CrashPoint: 2.18},
+			},
+			checked: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := VerifyCrashResults(seeds, test.results, test.scheme)
+			if report.Checked != test.checked || report.Skipped != test.skipped || len(report.Mismatches) != test.mismatches {
+				t.Errorf("report = %+v, want checked %d, skipped %d, mismatches %d",
+					report, test.checked, test.skipped, test.mismatches)
+			}
+		})
+	}
+}
+
+func TestVerifyCrashChain(t *testing.T) {
+	scheme := CrashScheme{Salt: testSalt, HouseEdge: DefaultCrashHouseEdge}
+
+	// os vetores vão do seed mais recente para o mais antigo
+	events := []blazego.CrashTickEvent{}
+	for i := len(crashVectors) - 1; i >= 0; i-- {
+		point := blazego.Float64String(crashVectors[i].point)
+		events = append(events,
+			blazego.CrashTickEvent{ID: crashVectors[i].seed[:8], Status: "graphing"},
+			blazego.CrashTickEvent{ID: crashVectors[i].seed[:8], Status: "complete", CrashPoint: &point},
+		)
+	}
+
+	report := VerifyCrash(crashVectors[0].seed, events, scheme)
+	if !report.OK() || report.Checked != len(crashVectors) {
+		t.Fatalf("VerifyCrash() = %+v", report)
+	}
+}
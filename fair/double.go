@@ -0,0 +1,238 @@
+package fair
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// DoubleScheme descreve como o número do double é derivado do seed da rodada, seguindo o script
+// de verificação publicado:
+//
+//	hash = HMAC-SHA256(chave = seed, mensagem = Salt)
+//	roll = parseInt(hash, 16) % 15
+//
+// parseInt converte o hash para um float64 do JavaScript, então o resto é calculado sobre o
+// hash arredondado para 53 bits de precisão, e não sobre o inteiro de 256 bits.
+type DoubleScheme struct {
+	// Salt é o valor público (hash de um bloco) definido antes do início da cadeia
+	Salt string
+}
+
+// DoubleRoll recalcula o número sorteado (0-14) e a cor da rodada a partir do seed
+func DoubleRoll(seed string, scheme DoubleScheme) (int, blazego.DoubleColor) {
+	roll := rollFromHash(gameHash(seed, scheme.Salt))
+
+	color, _ := blazego.DoubleColorFromRoll(roll)
+	return roll, color
+}
+
+func rollFromHash(hash string) int {
+	value, ok := new(big.Int).SetString(hash, 16)
+	if !ok {
+		return 0
+	}
+
+	// mesmo arredondamento do parseInt (mais próximo, empate para o par)
+	number, _ := new(big.Float).SetInt(value).Float64()
+	return int(math.Mod(number, 15))
+}
+
+// DoubleMismatch representa uma rodada cujo resultado gravado difere do recalculado
+type DoubleMismatch struct {
+	Index         int                 `json:"index"`
+	ID            string              `json:"id"`
+	Seed          string              `json:"seed"`
+	ExpectedRoll  int                 `json:"expected_roll"`
+	ExpectedColor blazego.DoubleColor `json:"expected_color"`
+	RecordedRoll  int                 `json:"recorded_roll"`
+	RecordedColor blazego.DoubleColor `json:"recorded_color"`
+}
+
+func (m DoubleMismatch) String() string {
+	return fmt.Sprintf("round %s (#%d): expected %d (%s), recorded %d (%s)",
+		m.ID, m.Index, m.ExpectedRoll, m.ExpectedColor, m.RecordedRoll, m.RecordedColor)
+}
+
+// DoubleReport representa o resultado da verificação de uma lista de rodadas do double
+type DoubleReport struct {
+	Checked    int              `json:"checked"`
+	Mismatches []DoubleMismatch `json:"mismatches"`
+}
+
+func (r DoubleReport) OK() bool {
+	return len(r.Mismatches) == 0
+}
+
+// First retorna a primeira rodada divergente em ordem cronológica
+func (r DoubleReport) First() (DoubleMismatch, bool) {
+	if len(r.Mismatches) == 0 {
+		return DoubleMismatch{}, false
+	}
+	return r.Mismatches[0], true
+}
+
+// VerifyDouble verifica os ticks gravados em ordem cronológica usando o seed da
+// rodada mais recente (a última da lista) para recalcular a cadeia inteira.
+// Apenas eventos com status "complete" são considerados.
+func VerifyDouble(seed string, events []blazego.DoubleTickEvent, scheme DoubleScheme) (DoubleReport, error) {
+	rounds, err := completedDoubleRounds(events)
+	if err != nil {
+		return DoubleReport{}, err
+	}
+	return VerifyDoubleRounds(reverseSeeds(seed, len(rounds)), rounds, scheme), nil
+}
+
+// VerifyDoubleRounds verifica as rodadas usando um seed por rodada, ambos em ordem cronológica
+func VerifyDoubleRounds(seeds []string, rounds []blazego.DoubleRound, scheme DoubleScheme) DoubleReport {
+	report := DoubleReport{Mismatches: []DoubleMismatch{}}
+
+	for i, round := range rounds {
+		if i >= len(seeds) {
+			break
+		}
+
+		report.Checked++
+		roll, color := DoubleRoll(seeds[i], scheme)
+		if roll != round.Roll || color != round.Color {
+			report.Mismatches = append(report.Mismatches, DoubleMismatch{
+				Index:         i,
+				ID:            round.ID,
+				Seed:          seeds[i],
+				ExpectedRoll:  roll,
+				ExpectedColor: color,
+				RecordedRoll:  round.Roll,
+				RecordedColor: round.Color,
+			})
+		}
+	}
+
+	return report
+}
+
+// VerifyDoubleFile verifica um histórico gravado em JSON Lines em ordem cronológica: uma sessão do
+// SessionRecorder (.jsonl ou .jsonl.gz) ou um tick do double por linha
+func VerifyDoubleFile(path, seed string, scheme DoubleScheme) (DoubleReport, error) {
+	session, err := isSessionFile(path)
+	if err != nil {
+		return DoubleReport{}, err
+	}
+
+	if session {
+		frames, err := blazego.LoadSessionFrames(path)
+		if err != nil {
+			return DoubleReport{}, err
+		}
+
+		rounds := blazego.DoubleRoundsFromFrames(frames)
+		return VerifyDoubleRounds(reverseSeeds(seed, len(rounds)), rounds, scheme), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return DoubleReport{}, err
+	}
+	defer file.Close()
+
+	events, err := ReadDoubleTicks(file)
+	if err != nil {
+		return DoubleReport{}, err
+	}
+
+	return VerifyDouble(seed, events, scheme)
+}
+
+// isSessionFile indica se a primeira linha do arquivo é um SessionFrame (com o frame bruto em "data")
+func isSessionFile(path string) (bool, error) {
+	if strings.HasSuffix(path, ".gz") {
+		return true, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var probe struct {
+			Data *string `json:"data"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &probe); err != nil {
+			return false, fmt.Errorf("line %d: %w", line, err)
+		}
+		return probe.Data != nil, nil
+	}
+
+	return false, scanner.Err()
+}
+
+// ReadDoubleTicks lê ticks do double em JSON Lines, ignorando linhas vazias
+func ReadDoubleTicks(r io.Reader) ([]blazego.DoubleTickEvent, error) {
+	events := []blazego.DoubleTickEvent{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var event blazego.DoubleTickEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		events = append(events, event)
+	}
+
+	return events, scanner.Err()
+}
+
+func completedDoubleRounds(events []blazego.DoubleTickEvent) ([]blazego.DoubleRound, error) {
+	rounds := []blazego.DoubleRound{}
+	seen := make(map[string]struct{})
+
+	for _, event := range events {
+		if event.Status != blazego.DoubleStatusComplete {
+			continue
+		}
+		if _, exists := seen[event.ID]; exists {
+			continue
+		}
+		seen[event.ID] = struct{}{}
+
+		roll, color, err := event.Result()
+		if err != nil {
+			return nil, err
+		}
+
+		rounds = append(rounds, blazego.DoubleRound{
+			ID:        event.ID,
+			Status:    event.Status,
+			Color:     color,
+			Roll:      roll,
+			CreatedAt: event.CreatedAt,
+			UpdatedAt: event.UpdatedAt,
+		})
+	}
+
+	return rounds, nil
+}
@@ -0,0 +1,133 @@
+package fair
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// vetores gerados pelo script de verificação publicado (parseInt(hash, 16) % 15 no Node.js),
+// a partir da cadeia de sha256("blazego"); o resto do inteiro de 256 bits daria outro número
+var doubleVectors = []struct {
+	seed string
+	roll int
+}{
+	{"177496d5fdb33cad28889a9db0f4715a54074a62d123ca662b3093136b50939b", 11},
+	{"150afb4bbdf7ef17076ec6c8131622d3108ec82949b11336276b83f1faa15a54", 0},
+	{"2696f20c5c2c4adb02f770de5b7737b20f696fecb772f6030808c30e49d58c99", 1},
+	{"ace4b15e95d3a4b29f6fbb9ee4933caf67a0d11dd637ddb3790bb281483fd3ab", 0},
+	{"c632a1141e04c29bf65776b07c90a061dbd21e86c5758ebae03d5848cb6afcaf", 9},
+	{"c692ad3ef00838e45e943742ab05728383d78bcd27e9431f9d32d1da386f91cf", 6},
+	{"6fd508559861e55c2041ffa26908348ec11cb64231cb62f4ca5eec4963fc1ce7", 14},
+	{"6da076db692bfcfd11701c280630d148f103c9569e05f211db082cde45b9eb5c", 5},
+	{"b440579eb839d706986a291efb104f8421fef79addddb4ffa93a06c9e2fab694", 1},
+	{"00c8fecce6279d542300e2ab62042643f1bdcbde17c3298ec426949f789cba73", 13},
+}
+
+func TestDoubleRoll(t *testing.T) {
+	scheme := DoubleScheme{Salt: testSalt}
+
+	for _, vector := range doubleVectors {
+		roll, color := DoubleRoll(vector.seed, scheme)
+		want, _ := blazego.DoubleColorFromRoll(vector.roll)
+		if roll != vector.roll || color != want {
+			t.Errorf("DoubleRoll(%s) = %d (%s), want %d (%s)", vector.seed[:8], roll, color, vector.roll, want)
+		}
+	}
+}
+
+// chainTicks retorna ticks finalizados em ordem cronológica para os 8 primeiros vetores, que
+// formam uma cadeia a partir do primeiro seed
+func chainTicks(t *testing.T) []blazego.DoubleTickEvent {
+	t.Helper()
+
+	ticks := []blazego.DoubleTickEvent{}
+	for i := 7; i >= 0; i-- {
+		roll := blazego.StringOrNumber(fmt.Sprint(doubleVectors[i].roll))
+		ticks = append(ticks, blazego.DoubleTickEvent{
+			ID:     fmt.Sprintf("round-%d", i),
+			Status: blazego.DoubleStatusComplete,
+			Roll:   &roll,
+		})
+	}
+	return ticks
+}
+
+func TestVerifyDoubleFile(t *testing.T) {
+	ticks := chainTicks(t)
+	dir := t.TempDir()
+
+	tickLines := []string{}
+	frameLines := []string{}
+	for _, tick := range ticks {
+		payload, err := json.Marshal(tick)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tickLines = append(tickLines, string(payload))
+
+		frame, err := json.Marshal(blazego.SessionFrame{
+			ConnectionID: "conn",
+			Room:         "double_room_1",
+			Event:        "double.tick",
+			Data:         `42["data",{"id":"double.tick","payload":` + string(payload) + `}]`,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		frameLines = append(frameLines, string(frame))
+	}
+
+	write := func(name string, lines []string) string {
+		path := filepath.Join(dir, name)
+		content := strings.Join(lines, "\n") + "\n"
+
+		if strings.HasSuffix(name, ".gz") {
+			file, err := os.Create(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			writer := gzip.NewWriter(file)
+			writer.Write([]byte(content))
+			writer.Close()
+			file.Close()
+			return path
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		seed  string
+		valid bool
+	}{
+		{"ticks", write("ticks.jsonl", tickLines), doubleVectors[0].seed, true},
+		{"session", write("session.jsonl", frameLines), doubleVectors[0].seed, true},
+		{"compressed session", write("session.jsonl.gz", frameLines), doubleVectors[0].seed, true},
+		{"session with empty first line", write("blank.jsonl", append([]string{""}, frameLines...)), doubleVectors[0].seed, true},
+		{"wrong seed", write("wrong.jsonl", frameLines), doubleVectors[1].seed, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report, err := VerifyDoubleFile(test.path, test.seed, DoubleScheme{Salt: testSalt})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.Checked != len(ticks) || report.OK() != test.valid {
+				t.Errorf("report = %+v, want %d checked and OK %v", report, len(ticks), test.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package blazego
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+var (
+	dataFrameRegex       = regexp.MustCompile(`^\d+\["data",\s*({.*})]$`)
+	dataFramePrefixRegex = regexp.MustCompile(`^\d+\["data"`)
+)
+
+// parseDataFrame extrai o id do evento e o payload de um frame 42["data",{...}] da Blaze
+func parseDataFrame(msg string) (string, interface{}, bool) {
+	matches := dataFrameRegex.FindStringSubmatch(msg)
+
+	if len(matches) < 2 {
+		return "", nil, false
+	}
+
+	var messageData struct {
+		Payload interface{} `json:"payload"`
+		ID      string      `json:"id"`
+	}
+
+	err := json.Unmarshal([]byte(matches[1]), &messageData)
+	if err != nil {
+		return "", nil, false
+	}
+
+	if messageData.Payload == nil || messageData.ID == "" {
+		return "", nil, false
+	}
+
+	return messageData.ID, messageData.Payload, true
+}
+
+// dataFrameEvent retorna apenas o id do evento de um frame "data". A leitura para no campo "id",
+// que a Blaze envia antes do payload, então o payload não é interpretado aqui.
+func dataFrameEvent(msg string) (string, bool) {
+	location := dataFrameRegex.FindStringSubmatchIndex(msg)
+	if location == nil {
+		return "", false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(msg[location[2]:location[3]]))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return "", false
+	}
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return "", false
+		}
+
+		if key == "id" {
+			var id string
+			if err := decoder.Decode(&id); err != nil || id == "" {
+				return "", false
+			}
+			return id, true
+		}
+
+		var skip json.RawMessage
+		if err := decoder.Decode(&skip); err != nil {
+			return "", false
+		}
+	}
+
+	return "", false
+}
+
+// isDataFrame indica se o frame é um evento "data", mesmo que o payload seja inválido
+func isDataFrame(msg string) bool {
+	return dataFramePrefixRegex.MatchString(msg)
+}
+
+func frameString(data interface{}) (string, bool) {
+	switch v := data.(type) {
+	case []byte:
+		return string(v), true
+	case string:
+		return v, true
+	default:
+		return "", false
+	}
+}
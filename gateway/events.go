@@ -0,0 +1,237 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// EventTypes são os eventos repassados pelo gateway; "close" é publicado quando a conexão de um jogo cai
+var EventTypes = []string{"crash.tick", "crash.tick-bets", "double.tick", "chat.message", "close"}
+
+const (
+	defaultBufferSize   = 1000
+	defaultClientBuffer = 256
+)
+
+// Event representa um evento tipado recebido de um jogo e repassado aos clientes
+type Event struct {
+	// ID é sequencial por gateway e serve para retomar o fluxo (Last-Event-ID)
+	ID      uint64          `json:"id"`
+	Game    string          `json:"game"`
+	Type    string          `json:"type"`
+	Time    time.Time       `json:"time"`
+	Payload json.RawMessage `json:"payload"`
+
+	data []byte // evento serializado uma única vez para todos os clientes
+}
+
+// Decode converte o payload no tipo do evento: blazego.CrashTickEvent, blazego.CrashTickBetsEvent,
+// blazego.DoubleTickEvent, blazego.ChatMessageEvent ou blazego.CloseEvent
+func (e Event) Decode() (interface{}, error) {
+	switch e.Type {
+	case "crash.tick":
+		return decodePayload[blazego.CrashTickEvent](e.Payload)
+	case "crash.tick-bets":
+		return decodePayload[blazego.CrashTickBetsEvent](e.Payload)
+	case "double.tick":
+		return decodePayload[blazego.DoubleTickEvent](e.Payload)
+	case "chat.message":
+		return decodePayload[blazego.ChatMessageEvent](e.Payload)
+	case "close":
+		return decodePayload[blazego.CloseEvent](e.Payload)
+	}
+	return nil, fmt.Errorf("unknown event type %q", e.Type)
+}
+
+func decodePayload[T any](payload json.RawMessage) (T, error) {
+	var value T
+	err := json.Unmarshal(payload, &value)
+	return value, err
+}
+
+// Filter seleciona os eventos entregues a uma assinatura; listas vazias aceitam tudo
+type Filter struct {
+	Games []string
+	Types []string
+}
+
+func (f Filter) Match(event Event) bool {
+	if len(f.Games) > 0 && !slices.Contains(f.Games, event.Game) {
+		return false
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, event.Type) {
+		return false
+	}
+	return true
+}
+
+// Subscription entrega os eventos publicados no gateway a um cliente. Um cliente que não
+// consome os eventos a tempo é removido e tem o canal fechado.
+type Subscription struct {
+	broker  *broker
+	filter  Filter
+	events  chan Event
+	evicted bool
+	closed  bool
+	missed  bool
+}
+
+// Events retorna o canal de eventos, fechado quando a assinatura termina
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Missed informa se o Resume pediu eventos que já saíram do buffer (ou de outro processo do
+// gateway); nesse caso o fluxo tem uma lacuna e o cliente deve recarregar o estado dos jogos
+func (s *Subscription) Missed() bool {
+	return s.missed
+}
+
+// SetFilter troca o filtro da assinatura sem perder nem repetir os eventos pendentes
+func (s *Subscription) SetFilter(filter Filter) {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	s.filter = filter
+}
+
+// Evicted informa se a assinatura foi removida por não acompanhar o fluxo
+func (s *Subscription) Evicted() bool {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	return s.evicted
+}
+
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	s.broker.remove(s)
+}
+
+// broker guarda os últimos eventos e distribui os novos para as assinaturas
+type broker struct {
+	mu            sync.Mutex
+	nextID        uint64
+	buffer        []Event
+	bufferSize    int
+	clientBuffer  int
+	subscriptions map[*Subscription]struct{}
+}
+
+func newBroker(bufferSize, clientBuffer int) *broker {
+	return &broker{
+		nextID:        1,
+		bufferSize:    bufferSize,
+		clientBuffer:  clientBuffer,
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+}
+
+func (b *broker) publish(game, eventType string, payload interface{}) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	event := Event{
+		ID:      b.nextID,
+		Game:    game,
+		Type:    eventType,
+		Time:    time.Now(),
+		Payload: raw,
+	}
+	event.data, err = json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	b.nextID++
+
+	b.buffer = append(b.buffer, event)
+	if len(b.buffer) > b.bufferSize {
+		b.buffer = slices.Delete(b.buffer, 0, len(b.buffer)-b.bufferSize)
+	}
+
+	for subscription := range b.subscriptions {
+		if !subscription.filter.Match(event) {
+			continue
+		}
+
+		select {
+		case subscription.events <- event:
+		default:
+			subscription.evicted = true
+			b.remove(subscription)
+		}
+	}
+
+	return nil
+}
+
+// subscribe registra a assinatura; com resume, os eventos guardados depois de after são entregues primeiro
+func (b *broker) subscribe(filter Filter, resume bool, after uint64) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	backlog := []Event{}
+	missed := false
+	if resume {
+		// o evento seguinte a after não está mais no buffer, ou after não foi publicado por este gateway
+		last := b.nextID - 1
+		missed = after > last || (after < last && b.buffer[0].ID > after+1)
+		for _, event := range b.buffer {
+			if event.ID > after && filter.Match(event) {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	subscription := &Subscription{
+		broker: b,
+		filter: filter,
+		events: make(chan Event, b.clientBuffer+len(backlog)),
+		missed: missed,
+	}
+	for _, event := range backlog {
+		subscription.events <- event
+	}
+
+	b.subscriptions[subscription] = struct{}{}
+	return subscription
+}
+
+func (b *broker) remove(subscription *Subscription) {
+	if subscription.closed {
+		return
+	}
+	subscription.closed = true
+	delete(b.subscriptions, subscription)
+	close(subscription.events)
+}
+
+func (b *broker) clients() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subscriptions)
+}
+
+// Subscribe assina os eventos publicados a partir de agora
+func (g *Gateway) Subscribe(filter Filter) *Subscription {
+	return g.events.subscribe(filter, false, 0)
+}
+
+// Resume assina os eventos publicados depois do ID informado, começando pelos que ainda
+// estão no buffer em memória
+func (g *Gateway) Resume(filter Filter, after uint64) *Subscription {
+	return g.events.subscribe(filter, true, after)
+}
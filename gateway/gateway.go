@@ -0,0 +1,488 @@
+// Package gateway expõe o estado ao vivo dos jogos da Blaze em HTTP/JSON para clientes que
+// não falam Socket.IO.
+//
+// O Gateway mantém uma conexão por jogo, reconectando com backoff quando ela cai, e alimenta
+// os trackers e históricos do blazego com os ticks recebidos. Ele implementa http.Handler e
+// pode ser montado em qualquer servidor com http.StripPrefix.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// Games são os jogos acompanhados quando Options.Games estiver vazio, incluindo o chat
+var Games = []string{"crash", "crash_2", "crash_neymarjr", "doubles", ChatGame}
+
+// ChatGame é o nome usado para acompanhar o chat junto com os jogos
+const ChatGame = "chat"
+
+const (
+	defaultHistorySize    = 500
+	defaultReconnectDelay = 5 * time.Second
+	maxReconnectDelay     = time.Minute
+	defaultWriteTimeout   = 10 * time.Second
+	defaultStaleAfter     = time.Minute
+)
+
+// Estados de um jogo em Health.Status
+const (
+	HealthOK           = "ok"
+	HealthStalled      = "stalled"
+	HealthDisconnected = "disconnected"
+)
+
+// Options configura o Gateway; campos zerados usam os valores padrão
+type Options struct {
+	Games       []string // jogos acompanhados (padrão: todos)
+	HistorySize int      // resultados mantidos por jogo (padrão 500)
+	// ReconnectDelay é a espera inicial antes de reconectar, dobrada a cada falha seguida até 1 minuto (padrão 5s)
+	ReconnectDelay time.Duration
+	// Connection personaliza a conexão de cada jogo (URL, token, replay...); Web e GameType são preenchidos pelo gateway
+	Connection func(game string) blazego.Connection
+	// AllowOrigin preenche o cabeçalho Access-Control-Allow-Origin das respostas (vazio desabilita)
+	AllowOrigin string
+	// Metrics registra as métricas das conexões do gateway e as serve em GET /metrics (nil desabilita)
+	Metrics *blazego.Metrics
+	// StaleAfter é o tempo sem eventos após o qual um jogo conectado é considerado travado (padrão 1 minuto)
+	StaleAfter time.Duration
+
+	BufferSize   int           // eventos guardados em memória para retomar o fluxo (padrão 1000)
+	ClientBuffer int           // eventos pendentes por cliente antes de removê-lo por lentidão (padrão 256)
+	Heartbeat    time.Duration // intervalo dos comentários de keep-alive do SSE (padrão 15s)
+	WriteTimeout time.Duration // prazo de cada escrita para um cliente (padrão 10s)
+}
+
+// CrashRound representa o estado da rodada atual de um jogo do crash
+type CrashRound struct {
+	Game         string   `json:"game"`
+	ID           string   `json:"id"`
+	Status       string   `json:"status"`
+	CrashPoint   *float64 `json:"crash_point,omitempty"`
+	IsBonusRound bool     `json:"is_bonus_round"`
+	UpdatedAt    string   `json:"updated_at"`
+	// StartedAt é quando o gateway recebeu o primeiro tick da rodada
+	StartedAt time.Time `json:"started_at"`
+}
+
+// Health representa o estado da conexão de um jogo
+type Health struct {
+	// Status é HealthOK, HealthStalled (conectado, mas sem eventos há mais de Options.StaleAfter)
+	// ou HealthDisconnected
+	Status      string    `json:"status"`
+	Connected   bool      `json:"connected"`
+	ConnectedAt time.Time `json:"connected_at,omitzero"`
+	LastEventAt time.Time `json:"last_event_at,omitzero"`
+	// LastEventAge é há quantos segundos chegou o último evento, ou desde a conexão antes do primeiro
+	LastEventAge float64 `json:"last_event_age,omitempty"`
+	Reconnects   int     `json:"reconnects"`
+	LastError    string  `json:"last_error,omitempty"`
+}
+
+// Gateway acompanha os jogos e serve o estado ao vivo em HTTP
+type Gateway struct {
+	options Options
+	games   map[string]*gameState
+	events  *broker
+	handler http.Handler
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type gameState struct {
+	mu      sync.RWMutex
+	game    string
+	crash   *blazego.CrashHistory
+	double  *blazego.DoubleHistory
+	tracker *blazego.DoubleRoundTracker
+	round   *CrashRound
+	health  Health
+	events  *broker
+}
+
+func New(options Options) *Gateway {
+	if len(options.Games) == 0 {
+		options.Games = Games
+	}
+	if options.HistorySize <= 0 {
+		options.HistorySize = defaultHistorySize
+	}
+	if options.ReconnectDelay <= 0 {
+		options.ReconnectDelay = defaultReconnectDelay
+	}
+	if options.BufferSize <= 0 {
+		options.BufferSize = defaultBufferSize
+	}
+	if options.ClientBuffer <= 0 {
+		options.ClientBuffer = defaultClientBuffer
+	}
+	if options.Heartbeat <= 0 {
+		options.Heartbeat = defaultHeartbeat
+	}
+	if options.WriteTimeout <= 0 {
+		options.WriteTimeout = defaultWriteTimeout
+	}
+	if options.StaleAfter <= 0 {
+		options.StaleAfter = defaultStaleAfter
+	}
+
+	g := &Gateway{
+		options: options,
+		games:   make(map[string]*gameState),
+		events:  newBroker(options.BufferSize, options.ClientBuffer),
+	}
+
+	for _, game := range options.Games {
+		state := &gameState{game: game, events: g.events}
+		switch game {
+		case ChatGame:
+		case "doubles":
+			state.double = blazego.NewDoubleHistory(options.HistorySize)
+			state.tracker = blazego.NewDoubleRoundTracker()
+		default:
+			state.crash = blazego.NewCrashHistory(game, options.HistorySize)
+		}
+		g.games[game] = state
+	}
+
+	g.handler = g.routes()
+
+	return g
+}
+
+// Start conecta a todos os jogos em segundo plano até o contexto ser cancelado ou Close ser chamado
+func (g *Gateway) Start(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.cancel != nil {
+		return errors.New("gateway already started")
+	}
+
+	for _, game := range g.options.Games {
+		if _, exists := blazego.RoomForGame(game); !exists && game != ChatGame {
+			return fmt.Errorf("unknown game %q", game)
+		}
+	}
+
+	ctx, g.cancel = context.WithCancel(ctx)
+	for _, state := range g.games {
+		g.wg.Add(1)
+		go func() {
+			defer g.wg.Done()
+			g.run(ctx, state)
+		}()
+	}
+
+	return nil
+}
+
+// Close encerra as conexões abertas pelo Start
+func (g *Gateway) Close() error {
+	g.mu.Lock()
+	cancel := g.cancel
+	g.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	g.wg.Wait()
+	return nil
+}
+
+// Attach alimenta o estado do jogo com os eventos de uma conexão já aberta, para quem
+// gerencia as próprias conexões no lugar do Start
+func (g *Gateway) Attach(game string, conn blazego.ConnectionSocketResponses) error {
+	state, exists := g.games[game]
+	if !exists {
+		return fmt.Errorf("game %q is not tracked", game)
+	}
+
+	state.attach(conn)
+	state.connected()
+
+	conn.On("close", func(data interface{}) {
+		closeEvent, _ := data.(blazego.CloseEvent)
+		state.disconnected(fmt.Errorf("connection closed with code %d", closeEvent.Code))
+		state.events.publish(game, "close", closeEvent)
+	})
+
+	return nil
+}
+
+func (g *Gateway) run(ctx context.Context, state *gameState) {
+	delay := g.options.ReconnectDelay
+
+	for {
+		connectedAt := time.Now()
+		err := g.connect(ctx, state)
+		if ctx.Err() != nil {
+			return
+		}
+		state.disconnected(err)
+
+		// uma conexão que ficou de pé por um tempo volta ao atraso inicial
+		if time.Since(connectedAt) > maxReconnectDelay {
+			delay = g.options.ReconnectDelay
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+
+		delay = min(delay*2, maxReconnectDelay)
+		state.reconnecting()
+	}
+}
+
+// connect abre a conexão do jogo e bloqueia até ela fechar ou o contexto ser cancelado
+func (g *Gateway) connect(ctx context.Context, state *gameState) error {
+	conn := blazego.Connection{}
+	if g.options.Connection != nil {
+		conn = g.options.Connection(state.game)
+	}
+	conn.Web = "blaze"
+	conn.GameType = state.game
+	if conn.Metrics == nil {
+		conn.Metrics = g.options.Metrics
+	}
+	if state.game == ChatGame {
+		conn.Web = "blaze-chat"
+		conn.GameType = ""
+	}
+
+	conn, start := blazego.DeferReplayStart(conn)
+	socket, err := blazego.MakeConnection(conn)
+	if err != nil {
+		return err
+	}
+	defer socket.Disconnect()
+
+	closed := make(chan blazego.CloseEvent, 1)
+	socket.On("close", func(data interface{}) {
+		closeEvent, _ := data.(blazego.CloseEvent)
+		select {
+		case closed <- closeEvent:
+		default:
+		}
+	})
+
+	state.attach(socket)
+	state.connected()
+	start()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case closeEvent := <-closed:
+		state.events.publish(state.game, "close", closeEvent)
+		return fmt.Errorf("connection closed with code %d", closeEvent.Code)
+	}
+}
+
+func (s *gameState) attach(conn blazego.ConnectionSocketResponses) {
+	switch {
+	case s.game == ChatGame:
+		conn.On("chat.message", func(data interface{}) {
+			message, err := blazego.DecodeEvent[blazego.ChatMessageEvent](data)
+			if err != nil {
+				return
+			}
+			s.touch()
+			s.events.publish(s.game, "chat.message", message)
+		})
+
+	case s.tracker != nil:
+		conn.On("double.tick", func(data interface{}) {
+			tickEvent, err := blazego.DecodeEvent[blazego.DoubleTickEvent](data)
+			if err != nil {
+				return
+			}
+			s.handleDouble(tickEvent)
+			s.events.publish(s.game, "double.tick", tickEvent)
+		})
+
+	default:
+		conn.On("crash.tick", func(data interface{}) {
+			tickEvent, err := blazego.DecodeEvent[blazego.CrashTickEvent](data)
+			if err != nil {
+				return
+			}
+			s.handleCrash(tickEvent)
+			s.events.publish(s.game, "crash.tick", tickEvent)
+		})
+
+		conn.On("crash.tick-bets", func(data interface{}) {
+			betsEvent, err := blazego.DecodeEvent[blazego.CrashTickBetsEvent](data)
+			if err != nil {
+				return
+			}
+			s.touch()
+			s.events.publish(s.game, "crash.tick-bets", betsEvent)
+		})
+	}
+}
+
+func (s *gameState) touch() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health.LastEventAt = time.Now()
+}
+
+func (s *gameState) handleCrash(event blazego.CrashTickEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health.LastEventAt = time.Now()
+
+	if s.round == nil || s.round.ID != event.ID {
+		s.round = &CrashRound{Game: s.game, ID: event.ID, StartedAt: time.Now()}
+	}
+
+	s.round.Status = event.Status
+	s.round.IsBonusRound = event.IsBonusRound
+	s.round.UpdatedAt = event.UpdatedAt
+	if event.CrashPoint != nil {
+		crashPoint := float64(*event.CrashPoint)
+		s.round.CrashPoint = &crashPoint
+	}
+
+	s.crash.Handle(event)
+}
+
+func (s *gameState) handleDouble(event blazego.DoubleTickEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health.LastEventAt = time.Now()
+
+	if err := s.tracker.Handle(event); err != nil {
+		s.health.LastError = err.Error()
+		return
+	}
+
+	// a rodada do tracker carrega o horário de início e o snapshot das apostas
+	if last, ok := s.tracker.Last(); ok && last.ID == event.ID {
+		s.double.Add(last)
+	}
+}
+
+func (s *gameState) connected() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health.Connected = true
+	s.health.ConnectedAt = time.Now()
+}
+
+func (s *gameState) disconnected(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health.Connected = false
+	if err != nil {
+		s.health.LastError = err.Error()
+	}
+}
+
+func (s *gameState) reconnecting() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health.Reconnects++
+}
+
+// Current retorna a rodada atual do jogo: *CrashRound para o crash ou blazego.DoubleRound para o
+// double (a última finalizada entre uma rodada e outra). Retorna false antes do primeiro tick.
+func (g *Gateway) Current(game string) (interface{}, bool) {
+	state, exists := g.games[game]
+	if !exists {
+		return nil, false
+	}
+
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+
+	if state.tracker != nil {
+		if round, ok := state.tracker.Current(); ok {
+			return round, true
+		}
+		if round, ok := state.tracker.Last(); ok {
+			return round, true
+		}
+		return nil, false
+	}
+
+	if state.crash == nil || state.round == nil {
+		return nil, false
+	}
+	round := *state.round
+	return &round, true
+}
+
+// History retorna os últimos n resultados do jogo em ordem cronológica:
+// []blazego.CrashResult para o crash ou []blazego.DoubleRound para o double
+func (g *Gateway) History(game string, n int) (interface{}, bool) {
+	state, exists := g.games[game]
+	if !exists {
+		return nil, false
+	}
+
+	switch {
+	case state.double != nil:
+		return state.double.Last(n), true
+	case state.crash != nil:
+		return state.crash.Last(n), true
+	}
+	return nil, false
+}
+
+// Games retorna os jogos acompanhados
+func (g *Gateway) Games() []string {
+	return slices.Clone(g.options.Games)
+}
+
+// Health retorna o estado da conexão de cada jogo
+func (g *Gateway) Health() map[string]Health {
+	now := time.Now()
+	health := make(map[string]Health, len(g.games))
+	for game, state := range g.games {
+		state.mu.RLock()
+		health[game] = state.health.at(now, g.options.StaleAfter)
+		state.mu.RUnlock()
+	}
+	return health
+}
+
+// at preenche o Status e a idade do último evento no instante now
+func (h Health) at(now time.Time, staleAfter time.Duration) Health {
+	if !h.Connected {
+		h.Status = HealthDisconnected
+		return h
+	}
+
+	last := h.ConnectedAt
+	if h.LastEventAt.After(last) {
+		last = h.LastEventAt
+	}
+	age := now.Sub(last)
+	h.LastEventAge = age.Seconds()
+
+	h.Status = HealthOK
+	if age > staleAfter {
+		h.Status = HealthStalled
+	}
+	return h
+}
@@ -0,0 +1,128 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+const defaultHistoryLimit = 50
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type healthResponse struct {
+	// Status é "ok" quando todos os jogos estão recebendo eventos, "degraded" quando apenas alguns
+	// estão e "down" quando nenhum está
+	Status string            `json:"status"`
+	Games  map[string]Health `json:"games"`
+	// Clients é a quantidade de clientes recebendo eventos
+	Clients int `json:"clients"`
+}
+
+func (g *Gateway) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", g.serveHealth)
+	mux.HandleFunc("GET /games", g.serveGames)
+	mux.HandleFunc("GET /games/{game}/current", g.serveCurrent)
+	mux.HandleFunc("GET /games/{game}/history", g.serveHistory)
+	mux.HandleFunc("GET /events", g.serveEvents)
+	mux.HandleFunc("GET /ws", g.serveRelay)
+	if g.options.Metrics != nil {
+		mux.Handle("GET /metrics", g.options.Metrics)
+	}
+	return mux
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if g.options.AllowOrigin != "" {
+		w.Header().Set("Access-Control-Allow-Origin", g.options.AllowOrigin)
+	}
+	g.handler.ServeHTTP(w, r)
+}
+
+func (g *Gateway) serveHealth(w http.ResponseWriter, r *http.Request) {
+	response := healthResponse{Status: "ok", Games: g.Health(), Clients: g.events.clients()}
+
+	healthy := 0
+	for _, health := range response.Games {
+		if health.Status == HealthOK {
+			healthy++
+		}
+	}
+
+	// o 503 fica para quando nenhum jogo está recebendo eventos; o estado de cada um vai no corpo
+	status := http.StatusOK
+	switch healthy {
+	case len(response.Games):
+	case 0:
+		response.Status = "down"
+		status = http.StatusServiceUnavailable
+	default:
+		response.Status = "degraded"
+	}
+
+	writeJSON(w, status, response)
+}
+
+func (g *Gateway) serveGames(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, g.Games())
+}
+
+func (g *Gateway) serveCurrent(w http.ResponseWriter, r *http.Request) {
+	game := r.PathValue("game")
+	if _, exists := g.games[game]; !exists {
+		writeError(w, http.StatusNotFound, "unknown game")
+		return
+	}
+	if game == ChatGame {
+		writeError(w, http.StatusNotFound, "game has no rounds")
+		return
+	}
+
+	round, ok := g.Current(game)
+	if !ok {
+		writeError(w, http.StatusNotFound, "no round received yet")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, round)
+}
+
+func (g *Gateway) serveHistory(w http.ResponseWriter, r *http.Request) {
+	limit := defaultHistoryLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		limit = min(parsed, g.options.HistorySize)
+	}
+
+	game := r.PathValue("game")
+	if _, exists := g.games[game]; !exists {
+		writeError(w, http.StatusNotFound, "unknown game")
+		return
+	}
+
+	history, ok := g.History(game, limit)
+	if !ok {
+		writeError(w, http.StatusNotFound, "game has no rounds")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, history)
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
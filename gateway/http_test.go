@@ -0,0 +1,161 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func TestServeHealth(t *testing.T) {
+	now := time.Now()
+	connected := Health{Connected: true, ConnectedAt: now.Add(-time.Hour), LastEventAt: now}
+	stalled := Health{Connected: true, ConnectedAt: now.Add(-time.Hour), LastEventAt: now.Add(-2 * time.Minute)}
+	waiting := Health{Connected: true, ConnectedAt: now}
+	disconnected := Health{LastEventAt: now}
+
+	tests := []struct {
+		name   string
+		games  map[string]Health
+		code   int
+		status string
+		want   map[string]string
+	}{
+		{"all ok", map[string]Health{"crash": connected, "doubles": waiting}, http.StatusOK, "ok",
+			map[string]string{"crash": HealthOK, "doubles": HealthOK}},
+		{"one disconnected", map[string]Health{"crash": connected, "doubles": disconnected}, http.StatusOK, "degraded",
+			map[string]string{"crash": HealthOK, "doubles": HealthDisconnected}},
+		{"one stalled", map[string]Health{"crash": stalled, "doubles": connected}, http.StatusOK, "degraded",
+			map[string]string{"crash": HealthStalled, "doubles": HealthOK}},
+		{"none ok", map[string]Health{"crash": stalled, "doubles": disconnected}, http.StatusServiceUnavailable, "down",
+			map[string]string{"crash": HealthStalled, "doubles": HealthDisconnected}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			games := []string{}
+			for game := range test.games {
+				games = append(games, game)
+			}
+			g := New(Options{Games: games})
+			for game, health := range test.games {
+				g.games[game].health = health
+			}
+
+			recorder := httptest.NewRecorder()
+			g.ServeHTTP(recorder, httptest.NewRequest("GET", "/health", nil))
+			if recorder.Code != test.code {
+				t.Errorf("code = %d, want %d", recorder.Code, test.code)
+			}
+
+			var response healthResponse
+			if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+			if response.Status != test.status {
+				t.Errorf("status = %s, want %s", response.Status, test.status)
+			}
+			for game, status := range test.want {
+				if got := response.Games[game].Status; got != status {
+					t.Errorf("%s status = %s, want %s", game, got, status)
+				}
+			}
+			if age := response.Games["crash"].LastEventAge; test.games["crash"].LastEventAt.Before(now) && age < 60 {
+				t.Errorf("crash last event age = %v", age)
+			}
+		})
+	}
+}
+
+func crashTick(id, status string, point float64) blazego.CrashTickEvent {
+	event := blazego.CrashTickEvent{ID: id, Status: status}
+	if point > 0 {
+		crashPoint := blazego.Float64String(point)
+		event.CrashPoint = &crashPoint
+	}
+	return event
+}
+
+func TestServeCurrent(t *testing.T) {
+	g := New(Options{Games: []string{"crash", "doubles", "chat"}})
+	g.games["crash"].handleCrash(crashTick("c1", "complete", 2))
+	g.games["crash"].handleCrash(crashTick("c2", "graphing", 0))
+
+	tests := []struct {
+		name string
+		path string
+		code int
+		want string
+	}{
+		{"crash", "/games/crash/current", http.StatusOK, `"id":"c2","status":"graphing"`},
+		{"no round yet", "/games/doubles/current", http.StatusNotFound, "no round received yet"},
+		{"game without rounds", "/games/chat/current", http.StatusNotFound, "game has no rounds"},
+		{"unknown game", "/games/roleta/current", http.StatusNotFound, "unknown game"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			g.ServeHTTP(recorder, httptest.NewRequest("GET", test.path, nil))
+			if recorder.Code != test.code {
+				t.Errorf("code = %d, want %d", recorder.Code, test.code)
+			}
+			if !strings.Contains(recorder.Body.String(), test.want) {
+				t.Errorf("body = %s, want %s", recorder.Body, test.want)
+			}
+		})
+	}
+}
+
+func TestServeHistory(t *testing.T) {
+	g := New(Options{Games: []string{"crash", "chat"}, HistorySize: 60})
+	for i := range 70 {
+		g.games["crash"].handleCrash(crashTick(fmt.Sprintf("c%d", i), "complete", 2))
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		code  int
+		count int
+	}{
+		{"default limit", "/games/crash/history", http.StatusOK, defaultHistoryLimit},
+		{"limit", "/games/crash/history?limit=3", http.StatusOK, 3},
+		{"limit above capacity", "/games/crash/history?limit=1000", http.StatusOK, 60},
+		{"invalid limit", "/games/crash/history?limit=ten", http.StatusBadRequest, 0},
+		{"negative limit", "/games/crash/history?limit=-1", http.StatusBadRequest, 0},
+		{"zero limit", "/games/crash/history?limit=0", http.StatusBadRequest, 0},
+		{"game without rounds", "/games/chat/history", http.StatusNotFound, 0},
+		{"unknown game", "/games/roleta/history", http.StatusNotFound, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			g.ServeHTTP(recorder, httptest.NewRequest("GET", test.path, nil))
+			if recorder.Code != test.code {
+				t.Fatalf("code = %d, want %d: %s", recorder.Code, test.code, recorder.Body)
+			}
+			if test.code != http.StatusOK {
+				return
+			}
+
+			var results []blazego.CrashResult
+			if err := json.Unmarshal(recorder.Body.Bytes(), &results); err != nil {
+				t.Fatal(err)
+			}
+			if len(results) != test.count {
+				t.Fatalf("results = %d, want %d", len(results), test.count)
+			}
+			// os resultados ficam em ordem cronológica e terminam na última rodada
+			if last := results[len(results)-1].ID; last != "c69" {
+				t.Errorf("last result = %s, want c69", last)
+			}
+		})
+	}
+}
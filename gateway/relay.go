@@ -0,0 +1,229 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// RelayMessage é a mensagem de controle trocada com os clientes do relay WebSocket.
+//
+// Clientes enviam {"type":"subscribe","games":["crash_2"],"types":["crash.tick"]},
+// {"type":"unsubscribe","games":["crash_2"]} ou {"type":"ping"}; games vazio em subscribe
+// assina todos os jogos e last_event_id retoma o fluxo a partir do buffer. O servidor
+// responde com "subscribed", "pong" ou "error" e envia os eventos no formato de Event.
+type RelayMessage struct {
+	Type        string   `json:"type"`
+	Games       []string `json:"games,omitempty"`
+	Types       []string `json:"types,omitempty"`
+	LastEventID uint64   `json:"last_event_id,omitempty"`
+	Error       string   `json:"error,omitempty"`
+}
+
+// relayClient guarda o que o cliente assinou
+type relayClient struct {
+	allGames bool
+	games    []string
+	types    []string
+}
+
+func (c *relayClient) filter() (Filter, bool) {
+	if !c.allGames && len(c.games) == 0 {
+		return Filter{}, false
+	}
+
+	filter := Filter{Types: c.types}
+	if !c.allGames {
+		// a assinatura guarda o filtro; c.games continua sendo alterado pelas próximas mensagens
+		filter.Games = slices.Clone(c.games)
+	}
+	return filter, true
+}
+
+func (g *Gateway) serveRelay(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{CheckOrigin: g.checkOrigin}
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer ws.Close()
+
+	messages := make(chan RelayMessage)
+	done := make(chan struct{})
+	defer close(done)
+
+	ws.SetReadLimit(64 * 1024)
+	ws.SetReadDeadline(time.Now().Add(2 * g.options.Heartbeat))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(2 * g.options.Heartbeat))
+	})
+
+	go func() {
+		defer close(messages)
+		for {
+			_, data, err := ws.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			var message RelayMessage
+			if err := json.Unmarshal(data, &message); err != nil {
+				message = RelayMessage{Type: "invalid"}
+			}
+
+			select {
+			case messages <- message:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	write := func(message interface{}) bool {
+		ws.SetWriteDeadline(time.Now().Add(g.options.WriteTimeout))
+		switch message := message.(type) {
+		case Event:
+			return ws.WriteMessage(websocket.TextMessage, message.data) == nil
+		default:
+			return ws.WriteJSON(message) == nil
+		}
+	}
+
+	client := &relayClient{}
+	var subscription *Subscription
+	defer func() {
+		if subscription != nil {
+			subscription.Close()
+		}
+	}()
+
+	// resubscribe aplica o filtro do cliente: com resume, a assinatura é refeita a partir de after;
+	// sem, o filtro da assinatura atual é trocado sem perder os eventos pendentes
+	resubscribe := func(resume bool, after uint64) {
+		filter, ok := client.filter()
+		if subscription != nil && (resume || !ok) {
+			subscription.Close()
+			subscription = nil
+		}
+
+		switch {
+		case !ok:
+		case resume:
+			subscription = g.Resume(filter, after)
+		case subscription == nil:
+			subscription = g.Subscribe(filter)
+		default:
+			subscription.SetFilter(filter)
+		}
+	}
+
+	heartbeat := time.NewTicker(g.options.Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		var events <-chan Event
+		if subscription != nil {
+			events = subscription.Events()
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-heartbeat.C:
+			deadline := time.Now().Add(g.options.WriteTimeout)
+			if err := ws.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+				return
+			}
+
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			if !write(g.handleRelayMessage(client, message, resubscribe)) {
+				return
+			}
+
+		case event, ok := <-events:
+			if !ok {
+				deadline := time.Now().Add(g.options.WriteTimeout)
+				ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "slow consumer"), deadline)
+				return
+			}
+			// eventos pendentes de jogos que o cliente acabou de deixar não são entregues
+			if filter, ok := client.filter(); !ok || !filter.Match(event) {
+				continue
+			}
+			if !write(event) {
+				return
+			}
+		}
+	}
+}
+
+func (g *Gateway) handleRelayMessage(client *relayClient, message RelayMessage, resubscribe func(resume bool, after uint64)) RelayMessage {
+	switch message.Type {
+	case "ping":
+		return RelayMessage{Type: "pong"}
+
+	case "subscribe":
+		for _, game := range message.Games {
+			if _, exists := g.games[game]; !exists {
+				return RelayMessage{Type: "error", Error: "unknown game " + game}
+			}
+		}
+
+		if len(message.Games) == 0 {
+			client.allGames = true
+		}
+		for _, game := range message.Games {
+			if !slices.Contains(client.games, game) {
+				client.games = append(client.games, game)
+			}
+		}
+		if message.Types != nil {
+			client.types = message.Types
+		}
+		// só o last_event_id enviado pelo cliente retoma o fluxo; sem ele a assinatura segue do ponto atual
+		resubscribe(message.LastEventID > 0, message.LastEventID)
+
+	case "unsubscribe":
+		if len(message.Games) == 0 {
+			client.games = nil
+		} else if client.allGames {
+			client.games = slices.Clone(g.options.Games)
+		}
+		client.allGames = false
+		client.games = slices.DeleteFunc(client.games, func(game string) bool {
+			return slices.Contains(message.Games, game)
+		})
+		resubscribe(false, 0)
+
+	case "invalid":
+		return RelayMessage{Type: "error", Error: "invalid message"}
+
+	default:
+		return RelayMessage{Type: "error", Error: "unknown message type"}
+	}
+
+	subscribed := RelayMessage{Type: "subscribed", Games: client.games, Types: client.types}
+	if client.allGames {
+		subscribed.Games = g.options.Games
+	}
+	return subscribed
+}
+
+// checkOrigin aceita a mesma origem ou a origem configurada em Options.AllowOrigin
+func (g *Gateway) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || g.options.AllowOrigin == "*" || origin == g.options.AllowOrigin {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == r.Host
+}
@@ -0,0 +1,97 @@
+package gateway
+
+import (
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func dialRelay(t *testing.T, g *Gateway) *websocket.Conn {
+	server := httptest.NewServer(g)
+	t.Cleanup(server.Close)
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ws.Close() })
+	return ws
+}
+
+// relayCommand envia a mensagem e devolve a resposta "subscribed"
+func relayCommand(t *testing.T, ws *websocket.Conn, message RelayMessage) RelayMessage {
+	if err := ws.WriteJSON(message); err != nil {
+		t.Fatal(err)
+	}
+
+	var reply RelayMessage
+	ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if err := ws.ReadJSON(&reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Type != "subscribed" {
+		t.Fatalf("reply = %+v", reply)
+	}
+	return reply
+}
+
+// relayEvents lê n eventos e devolve "jogo:id" de cada um
+func relayEvents(t *testing.T, ws *websocket.Conn, n int) []string {
+	events := []string{}
+	for range n {
+		var event Event
+		ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if err := ws.ReadJSON(&event); err != nil {
+			t.Fatalf("events = %v: %v", events, err)
+		}
+		events = append(events, event.Game+":"+string(event.Payload))
+	}
+	return events
+}
+
+func TestRelayUnsubscribeAllGames(t *testing.T) {
+	g := New(Options{Games: []string{"crash", "doubles"}})
+	ws := dialRelay(t, g)
+
+	relayCommand(t, ws, RelayMessage{Type: "subscribe"})
+	reply := relayCommand(t, ws, RelayMessage{Type: "unsubscribe", Games: []string{"crash"}})
+	if !slices.Equal(reply.Games, []string{"doubles"}) {
+		t.Fatalf("subscribed games = %v, want [doubles]", reply.Games)
+	}
+
+	g.events.publish("crash", "crash.tick", 1)
+	g.events.publish("doubles", "double.tick", 2)
+
+	if events := relayEvents(t, ws, 1); events[0] != "doubles:2" {
+		t.Errorf("events = %v, want [doubles:2]", events)
+	}
+}
+
+func TestRelaySubscribeFromNow(t *testing.T) {
+	g := New(Options{Games: []string{"crash", "doubles"}})
+	ws := dialRelay(t, g)
+
+	relayCommand(t, ws, RelayMessage{Type: "subscribe", Games: []string{"crash"}})
+	g.events.publish("crash", "crash.tick", 1)
+	g.events.publish("doubles", "double.tick", 2)
+	if events := relayEvents(t, ws, 1); events[0] != "crash:1" {
+		t.Fatalf("events = %v, want [crash:1]", events)
+	}
+
+	// o double.tick anterior não é reenviado sem last_event_id
+	relayCommand(t, ws, RelayMessage{Type: "subscribe", Games: []string{"doubles"}})
+	g.events.publish("doubles", "double.tick", 3)
+	if events := relayEvents(t, ws, 1); events[0] != "doubles:3" {
+		t.Errorf("events = %v, want [doubles:3]", events)
+	}
+
+	// com last_event_id o fluxo é retomado do buffer
+	relayCommand(t, ws, RelayMessage{Type: "subscribe", LastEventID: 1})
+	if events := relayEvents(t, ws, 2); !slices.Equal(events, []string{"doubles:2", "doubles:3"}) {
+		t.Errorf("events = %v, want [doubles:2 doubles:3]", events)
+	}
+}
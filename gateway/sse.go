@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultHeartbeat = 15 * time.Second
+
+// serveEvents transmite os eventos em Server-Sent Events. Filtros: ?games=crash_2,doubles e
+// ?types=crash.tick,double.tick; o cabeçalho Last-Event-ID (ou ?last_event_id=) retoma o fluxo,
+// precedido de um evento "reset" quando os eventos seguintes a ele já saíram do buffer.
+func (g *Gateway) serveEvents(w http.ResponseWriter, r *http.Request) {
+	filter := Filter{
+		Games: queryList(r, "games"),
+		Types: queryList(r, "types"),
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+
+	var subscription *Subscription
+	if lastEventID != "" {
+		after, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid last event id")
+			return
+		}
+		subscription = g.Resume(filter, after)
+	} else {
+		subscription = g.Subscribe(filter)
+	}
+	defer subscription.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// o prazo de escrita evita que um cliente que não lê o socket prenda o handler
+	controller := http.NewResponseController(w)
+	write := func(format string, args ...interface{}) bool {
+		controller.SetWriteDeadline(time.Now().Add(g.options.WriteTimeout))
+		if _, err := fmt.Fprintf(w, format, args...); err != nil {
+			return false
+		}
+		return controller.Flush() == nil
+	}
+
+	if !write("retry: %d\n\n", g.options.ReconnectDelay.Milliseconds()) {
+		return
+	}
+
+	// o Last-Event-ID é mais antigo que o buffer: avisa a lacuna antes dos eventos que restaram
+	if subscription.Missed() && !write("event: reset\ndata: {\"last_event_id\":%s}\n\n", lastEventID) {
+		return
+	}
+
+	heartbeat := time.NewTicker(g.options.Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-heartbeat.C:
+			if !write(": ping\n\n") {
+				return
+			}
+
+		case event, ok := <-subscription.Events():
+			if !ok {
+				// removido por lentidão; o cliente reconecta com o Last-Event-ID
+				return
+			}
+			if !write("id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.data) {
+				return
+			}
+		}
+	}
+}
+
+// queryList aceita valores separados por vírgula ou o parâmetro repetido
+func queryList(r *http.Request, name string) []string {
+	values := []string{}
+	for _, value := range r.URL.Query()[name] {
+		for item := range strings.SplitSeq(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,68 @@
+package gateway
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServeEventsResume(t *testing.T) {
+	tests := []struct {
+		name        string
+		lastEventID string
+		want        []string
+	}{
+		{"inside buffer", "3", []string{"id: 4", "id: 5"}},
+		{"older than buffer", "1", []string{"event: reset", "id: 4", "id: 5"}},
+		{"from another gateway", "99", []string{"event: reset"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := New(Options{Games: []string{"crash"}, BufferSize: 2})
+			for range 5 {
+				g.events.publish("crash", "crash.tick", map[string]string{"status": "waiting"})
+			}
+
+			server := httptest.NewServer(g)
+			defer server.Close()
+
+			request, _ := http.NewRequest("GET", server.URL+"/events", nil)
+			request.Header.Set("Last-Event-ID", test.lastEventID)
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer response.Body.Close()
+
+			lines := make(chan string)
+			go func() {
+				defer close(lines)
+				scanner := bufio.NewScanner(response.Body)
+				for scanner.Scan() {
+					if line := scanner.Text(); strings.HasPrefix(line, "id: ") || strings.HasPrefix(line, "event: reset") {
+						lines <- line
+					}
+				}
+			}()
+
+			got := []string{}
+			for len(got) < len(test.want) {
+				select {
+				case line := <-lines:
+					got = append(got, line)
+				case <-time.After(5 * time.Second):
+					t.Fatalf("got %v, want %v", got, test.want)
+				}
+			}
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Fatalf("got %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Package emitter implementa a entrega ordenada de eventos usada pelas conexões, trackers e
+// paper traders do blazego.
+package emitter
+
+import (
+	"sync"
+	"time"
+)
+
+type emission struct {
+	callbacks []func(interface{})
+	data      interface{}
+	emittedAt time.Time
+}
+
+// Emitter entrega os eventos fora da goroutine de quem emite, mas sempre na ordem
+// em que foram emitidos. Um callback lento atrasa os eventos seguintes do mesmo Emitter.
+type Emitter struct {
+	mu              sync.Mutex
+	callbacks       map[string][]func(interface{})
+	queue           []emission
+	running         bool
+	queueDepth      func(depth int)
+	callbackLatency func(latency time.Duration)
+}
+
+func New() *Emitter {
+	return &Emitter{
+		callbacks: make(map[string][]func(interface{})),
+	}
+}
+
+func (e *Emitter) On(event string, callback func(data interface{})) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.callbacks[event] = append(e.callbacks[event], callback)
+}
+
+// Instrument registra a profundidade da fila a cada mudança e a latência entre a emissão e o fim
+// dos callbacks de cada evento
+func (e *Emitter) Instrument(queueDepth func(depth int), callbackLatency func(latency time.Duration)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.queueDepth = queueDepth
+	e.callbackLatency = callbackLatency
+}
+
+func (e *Emitter) Emit(event string, data interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	callbacks, exists := e.callbacks[event]
+	if !exists {
+		return
+	}
+
+	e.queue = append(e.queue, emission{
+		callbacks: callbacks[:len(callbacks):len(callbacks)],
+		data:      data,
+		emittedAt: time.Now(),
+	})
+	if e.queueDepth != nil {
+		e.queueDepth(len(e.queue))
+	}
+
+	if !e.running {
+		e.running = true
+		go e.dispatch()
+	}
+}
+
+func (e *Emitter) dispatch() {
+	for {
+		e.mu.Lock()
+		if len(e.queue) == 0 {
+			e.running = false
+			e.mu.Unlock()
+			return
+		}
+
+		item := e.queue[0]
+		e.queue[0] = emission{}
+		e.queue = e.queue[1:]
+		if e.queueDepth != nil {
+			e.queueDepth(len(e.queue))
+		}
+		callbackLatency := e.callbackLatency
+		e.mu.Unlock()
+
+		for _, callback := range item.callbacks {
+			callback(item.data)
+		}
+		if callbackLatency != nil {
+			callbackLatency(time.Since(item.emittedAt))
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package emitter
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEmitterOrder(t *testing.T) {
+	e := New()
+
+	const events = 1000
+	received := make(chan int, events)
+	e.On("tick", func(data interface{}) {
+		received <- data.(int)
+	})
+	e.Emit("ignored", -1)
+
+	for i := range events {
+		e.Emit("tick", i)
+	}
+
+	for i := range events {
+		select {
+		case got := <-received:
+			if got != i {
+				t.Fatalf("event %d delivered as %d", i, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+		}
+	}
+}
+
+func TestEmitterInstrument(t *testing.T) {
+	e := New()
+
+	var mu sync.Mutex
+	depths := []int{}
+	latencies := 0
+	done := make(chan struct{})
+	e.Instrument(func(depth int) {
+		mu.Lock()
+		depths = append(depths, depth)
+		mu.Unlock()
+	}, func(latency time.Duration) {
+		mu.Lock()
+		latencies++
+		if latencies == 2 {
+			close(done)
+		}
+		mu.Unlock()
+	})
+
+	release := make(chan struct{})
+	e.On("tick", func(data interface{}) {
+		<-release
+	})
+
+	e.Emit("tick", 1)
+	e.Emit("tick", 2)
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if last := depths[len(depths)-1]; last != 0 {
+		t.Errorf("depths = %v, want to end at 0", depths)
+	}
+}
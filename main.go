@@ -2,7 +2,6 @@ package blazego
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"maps"
 )
@@ -21,6 +20,9 @@ type Connection struct {
 	CacheIgnoreRepeatedEvents *bool
 	Web                       string
 	GameType                  string
+	Recorder                  *SessionRecorder // grava todos os frames recebidos (opcional)
+	Replay                    *ReplayOptions   // reproduz uma sessão gravada no lugar da Blaze (opcional)
+	Metrics                   *Metrics         // registra as métricas da conexão (opcional)
 }
 
 type ConnectionOptions struct {
@@ -84,9 +86,11 @@ func MakeConnection(conn Connection) (ConnectionSocketResponses, error) {
 				Headers: headers,
 			},
 			TimeoutPing: conn.TimeoutPing,
+			Metrics:     conn.Metrics,
 		}
 
-		socket := NewNodeConnectionSocket()
+		room, _ := RoomForGame(conn.GameType)
+		socket := newConnectionSocket(conn, room)
 
 		cacheIgnoreRepeatedEvents := true
 		if conn.CacheIgnoreRepeatedEvents != nil {
@@ -144,9 +148,10 @@ func MakeConnection(conn Connection) (ConnectionSocketResponses, error) {
 				Headers: headers,
 			},
 			TimeoutPing: conn.TimeoutPing,
+			Metrics:     conn.Metrics,
 		}
 
-		socketForMessages := NewNodeConnectionSocket()
+		socketForMessages := newConnectionSocket(conn, ChatRoom)
 		blazeSocketForMessages := NewBlazeMessageSocket(socketForMessages)
 		err := blazeSocketForMessages.Connect(socketOptions)
 		if err != nil {
@@ -160,24 +165,40 @@ func MakeConnection(conn Connection) (ConnectionSocketResponses, error) {
 	}
 }
 
+func newConnectionSocket(conn Connection, room string) ConnectionSocket {
+	if conn.Replay != nil {
+		replayOptions := *conn.Replay
+		if replayOptions.Room == "" {
+			replayOptions.Room = room
+		}
+		return NewReplayConnectionSocket(replayOptions)
+	}
+
+	socket := NewNodeConnectionSocket()
+	if conn.Recorder != nil {
+		socket.Record(conn.Recorder, room)
+	}
+	return socket
+}
+
 type GameEventResult struct {
 	Events []CrashTickEvent `json:"events"`
 	Error  error            `json:"error,omitempty"`
 }
 
-// GetNextGameEventTick aguarda o próximo jogo completo e retorna todos os eventos
-// 1. Conecta ao crash
+// GetNextGameEventTick aguarda o próximo jogo completo e envia cada tick em tempo real
+// 1. Conecta ao jogo
 // 2. Aguarda status "waiting" (início do próximo jogo)
-// 3. Coleta todos os eventos crash.tick dessa rodada
-// 4. Quando status for "complete", encerra a conexão e retorna os eventos
+// 3. Envia todos os eventos crash.tick ou double.tick dessa rodada
+// 4. Quando status for "complete", encerra a conexão e fecha os canais
 func GetNextGameEventTick(gameType string) (<-chan any, <-chan error) {
 	return GetNextGameEventTickWithContext(context.Background(), gameType)
 }
 
-// GetNextGameEventTickWithContext agora retorna um canal de eventos em tempo real e um canal de erro.
-// O canal de eventos envia cada CrashTickEvent assim que recebido.
+// GetNextGameEventTickWithContext retorna um canal de eventos em tempo real e um canal de erro.
+// O canal de eventos envia cada CrashTickEvent ou DoubleTickEvent (conforme o jogo) assim que recebido.
 // O canal de erro envia qualquer erro ocorrido durante o processo.
-// O canal de eventos é fechado quando o jogo termina ou ocorre erro/cancelamento.
+// Os canais são fechados quando o jogo termina ou ocorre erro/cancelamento.
 func GetNextGameEventTickWithContext(ctx context.Context, gameType string) (<-chan any, <-chan error) {
 	eventChan := make(chan any)
 	errorChan := make(chan error, 1)
@@ -186,86 +207,17 @@ func GetNextGameEventTickWithContext(ctx context.Context, gameType string) (<-ch
 		defer close(eventChan)
 		defer close(errorChan)
 
-		conn, err := MakeConnection(Connection{
-			GameType: gameType,
-			Web:      "blaze",
+		err := watchRounds(ctx, Connection{GameType: gameType, Web: "blaze"}, 1, func(round *Round, tick roundTick) error {
+			select {
+			case eventChan <- tick.event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
 		})
 		if err != nil {
-			errorChan <- fmt.Errorf("erro ao conectar: %w", err)
-			return
+			errorChan <- err
 		}
-
-		gameStarted := false
-
-		conn.On("crash.tick", func(data interface{}) {
-			dataBytes, err := json.Marshal(data)
-			if err != nil {
-				errorChan <- err
-				return
-			}
-
-			var tickEvent CrashTickEvent
-			if err := json.Unmarshal(dataBytes, &tickEvent); err != nil {
-				errorChan <- err
-				return
-			}
-
-			if !gameStarted {
-				if tickEvent.Status == "waiting" {
-					gameStarted = true
-					eventChan <- tickEvent
-				}
-				return
-			}
-
-			eventChan <- tickEvent
-
-			if tickEvent.Status == "complete" {
-				go func() {
-					conn.Disconnect()
-				}()
-			}
-		})
-
-		conn.On("double.tick", func(data interface{}) {
-			dataBytes, err := json.Marshal(data)
-			if err != nil {
-				errorChan <- err
-				return
-			}
-
-			var tickEvent DoubleTickEvent
-			if err := json.Unmarshal(dataBytes, &tickEvent); err != nil {
-				errorChan <- err
-				return
-			}
-
-			if !gameStarted {
-				if tickEvent.Status == "waiting" {
-					gameStarted = true
-					eventChan <- tickEvent
-				}
-				return
-			}
-
-			eventChan <- tickEvent
-
-			if tickEvent.Status == "complete" {
-				go func() {
-					conn.Disconnect()
-				}()
-			}
-		})
-
-		conn.On("close", func(data interface{}) {
-			if !gameStarted {
-				errorChan <- fmt.Errorf("connection closed before game started")
-			}
-		})
-
-		<-ctx.Done()
-		conn.Disconnect()
-		errorChan <- ctx.Err()
 	}()
 
 	return eventChan, errorChan
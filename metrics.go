@@ -0,0 +1,302 @@
+package blazego
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	pingBuckets     = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+	callbackBuckets = []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5}
+)
+
+// Metrics acumula as métricas das conexões no formato de texto do Prometheus.
+// Uma instância pode ser compartilhada por várias conexões (Connection.Metrics), inclusive do
+// mesmo jogo: as séries são rotuladas pelo jogo e somam as conexões dele. É um http.Handler
+// para ser montado em /metrics.
+type Metrics struct {
+	mu       sync.Mutex
+	families []*metricFamily
+
+	connected       *metricFamily
+	connections     *metricFamily
+	reconnects      *metricFamily
+	pingRTT         *metricFamily
+	framesReceived  *metricFamily
+	framesSent      *metricFamily
+	bytesReceived   *metricFamily
+	bytesSent       *metricFamily
+	events          *metricFamily
+	parseFailures   *metricFamily
+	dedupeHits      *metricFamily
+	queueDepth      *metricFamily
+	callbackLatency *metricFamily
+}
+
+type metricFamily struct {
+	name    string
+	help    string
+	kind    string // "counter", "gauge" ou "histogram"
+	buckets []float64
+	series  map[string]*metricSeries
+}
+
+type metricSeries struct {
+	value  float64
+	counts []uint64 // contagem por bucket, não acumulada
+	sum    float64
+	count  uint64
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{}
+
+	m.connected = m.family("blazego_connected", "gauge", "Conexões abertas do jogo.", nil)
+	m.connections = m.family("blazego_connections_total", "counter", "Conexões abertas.", nil)
+	m.reconnects = m.family("blazego_reconnects_total", "counter", "Conexões reabertas depois de cair.", nil)
+	m.pingRTT = m.family("blazego_ping_rtt_seconds", "histogram", "Tempo entre o ping e o pong do Engine.IO.", pingBuckets)
+	m.framesReceived = m.family("blazego_frames_received_total", "counter", "Frames recebidos.", nil)
+	m.framesSent = m.family("blazego_frames_sent_total", "counter", "Frames enviados.", nil)
+	m.bytesReceived = m.family("blazego_received_bytes_total", "counter", "Bytes recebidos.", nil)
+	m.bytesSent = m.family("blazego_sent_bytes_total", "counter", "Bytes enviados.", nil)
+	m.events = m.family("blazego_events_total", "counter", "Eventos entregues por tipo.", nil)
+	m.parseFailures = m.family("blazego_parse_failures_total", "counter", "Frames descartados por não serem interpretados.", nil)
+	m.dedupeHits = m.family("blazego_dedupe_hits_total", "counter", "Eventos repetidos ignorados pelo cache.", nil)
+	m.queueDepth = m.family("blazego_callback_queue_depth", "gauge", "Eventos aguardando os callbacks.", nil)
+	m.callbackLatency = m.family("blazego_callback_latency_seconds", "histogram", "Tempo entre a emissão do evento e o fim dos callbacks.", callbackBuckets)
+
+	return m
+}
+
+func (m *Metrics) family(name, kind, help string, buckets []float64) *metricFamily {
+	family := &metricFamily{
+		name:    name,
+		help:    help,
+		kind:    kind,
+		buckets: buckets,
+		series:  make(map[string]*metricSeries),
+	}
+	m.families = append(m.families, family)
+	return family
+}
+
+func (m *Metrics) add(family *metricFamily, value float64, labels ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	family.get(labels).value += value
+}
+
+func (m *Metrics) observe(family *metricFamily, value float64, labels ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	series := family.get(labels)
+	if i, _ := slices.BinarySearch(family.buckets, value); i < len(family.buckets) {
+		series.counts[i]++
+	}
+	series.sum += value
+	series.count++
+}
+
+// get retorna a série dos rótulos informados em pares nome, valor; quem chama segura m.mu
+func (f *metricFamily) get(labels []string) *metricSeries {
+	key := formatLabels(labels)
+
+	series, exists := f.series[key]
+	if !exists {
+		series = &metricSeries{counts: make([]uint64, len(f.buckets))}
+		f.series[key] = series
+	}
+	return series
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatLabels(labels []string) string {
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, labels[i]+`="`+labelEscaper.Replace(labels[i+1])+`"`)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// Write escreve as métricas no formato de texto do Prometheus
+func (m *Metrics) Write(w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	buffer := bufio.NewWriter(w)
+	for _, family := range m.families {
+		buffer.WriteString("# HELP " + family.name + " " + family.help + "\n")
+		buffer.WriteString("# TYPE " + family.name + " " + family.kind + "\n")
+
+		keys := make([]string, 0, len(family.series))
+		for key := range family.series {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			family.write(buffer, key, family.series[key])
+		}
+	}
+	return buffer.Flush()
+}
+
+func (f *metricFamily) write(w *bufio.Writer, labels string, series *metricSeries) {
+	if f.kind != "histogram" {
+		w.WriteString(f.name + braces(labels) + " " + formatValue(series.value) + "\n")
+		return
+	}
+
+	prefix := labels
+	if prefix != "" {
+		prefix += ","
+	}
+
+	cumulative := uint64(0)
+	for i, bucket := range f.buckets {
+		cumulative += series.counts[i]
+		w.WriteString(f.name + `_bucket{` + prefix + `le="` + formatValue(bucket) + `"} ` + strconv.FormatUint(cumulative, 10) + "\n")
+	}
+	w.WriteString(f.name + `_bucket{` + prefix + `le="+Inf"} ` + strconv.FormatUint(series.count, 10) + "\n")
+	w.WriteString(f.name + "_sum" + braces(labels) + " " + formatValue(series.sum) + "\n")
+	w.WriteString(f.name + "_count" + braces(labels) + " " + strconv.FormatUint(series.count, 10) + "\n")
+}
+
+func braces(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.Write(w)
+}
+
+// connectionMetrics registra as métricas de uma conexão e das reconexões dela; todos os métodos
+// aceitam receptor nil
+type connectionMetrics struct {
+	metrics  *Metrics
+	game     string
+	pingAt   atomic.Int64 // horário do último ping ainda sem pong (UnixNano)
+	reopened atomic.Bool  // a partir da segunda abertura, cada uma conta como reconexão
+	open     atomic.Bool
+	depth    atomic.Int64 // última profundidade da fila, somada ao gauge do jogo
+}
+
+func newConnectionMetrics(metrics *Metrics, game string) *connectionMetrics {
+	if metrics == nil {
+		return nil
+	}
+	return &connectionMetrics{metrics: metrics, game: game}
+}
+
+func (c *connectionMetrics) opened() {
+	if c == nil {
+		return
+	}
+	if c.open.Swap(true) {
+		return
+	}
+
+	c.metrics.add(c.metrics.connected, 1, "game", c.game)
+	c.metrics.add(c.metrics.connections, 1, "game", c.game)
+	if c.reopened.Swap(true) {
+		c.metrics.add(c.metrics.reconnects, 1, "game", c.game)
+	}
+}
+
+func (c *connectionMetrics) closed() {
+	if c == nil {
+		return
+	}
+	if c.open.Swap(false) {
+		c.metrics.add(c.metrics.connected, -1, "game", c.game)
+	}
+}
+
+func (c *connectionMetrics) frameReceived(frame string) {
+	if c == nil {
+		return
+	}
+
+	c.metrics.add(c.metrics.framesReceived, 1, "game", c.game)
+	c.metrics.add(c.metrics.bytesReceived, float64(len(frame)), "game", c.game)
+
+	// "3" é o pong do Engine.IO
+	if frame == "3" {
+		if pingAt := c.pingAt.Swap(0); pingAt != 0 {
+			rtt := time.Since(time.Unix(0, pingAt))
+			c.metrics.observe(c.metrics.pingRTT, rtt.Seconds(), "game", c.game)
+		}
+	}
+}
+
+func (c *connectionMetrics) frameSent(data interface{}) {
+	if c == nil {
+		return
+	}
+
+	frame, ok := frameString(data)
+	if !ok {
+		return
+	}
+
+	c.metrics.add(c.metrics.framesSent, 1, "game", c.game)
+	c.metrics.add(c.metrics.bytesSent, float64(len(frame)), "game", c.game)
+
+	if frame == "2" {
+		c.pingAt.Store(time.Now().UnixNano())
+	}
+}
+
+func (c *connectionMetrics) event(event string) {
+	if c == nil {
+		return
+	}
+	c.metrics.add(c.metrics.events, 1, "game", c.game, "event", event)
+}
+
+func (c *connectionMetrics) parseFailure(reason string) {
+	if c == nil {
+		return
+	}
+	c.metrics.add(c.metrics.parseFailures, 1, "game", c.game, "reason", reason)
+}
+
+func (c *connectionMetrics) dedupeHit() {
+	if c == nil {
+		return
+	}
+	c.metrics.add(c.metrics.dedupeHits, 1, "game", c.game)
+}
+
+func (c *connectionMetrics) queueDepth(depth int) {
+	if c == nil {
+		return
+	}
+	previous := c.depth.Swap(int64(depth))
+	c.metrics.add(c.metrics.queueDepth, float64(int64(depth)-previous), "game", c.game)
+}
+
+func (c *connectionMetrics) callbackLatency(latency time.Duration) {
+	if c == nil {
+		return
+	}
+	c.metrics.observe(c.metrics.callbackLatency, latency.Seconds(), "game", c.game)
+}
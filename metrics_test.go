@@ -0,0 +1,97 @@
+package blazego
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// metricLines retorna as linhas de amostra que começam com o prefixo informado
+func metricLines(t *testing.T, metrics *Metrics, prefix string) []string {
+	t.Helper()
+
+	var output strings.Builder
+	if err := metrics.Write(&output); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := []string{}
+	for line := range strings.SplitSeq(output.String(), "\n") {
+		if strings.HasPrefix(line, prefix) {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func equalLines(t *testing.T, got, want []string) {
+	t.Helper()
+
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestMetricsHistogram(t *testing.T) {
+	metrics := NewMetrics()
+	connection := newConnectionMetrics(metrics, "crash")
+
+	for _, latency := range []time.Duration{50 * time.Microsecond, 100 * time.Microsecond, 3 * time.Millisecond, 3 * time.Millisecond, 10 * time.Second} {
+		connection.callbackLatency(latency)
+	}
+
+	equalLines(t, metricLines(t, metrics, "blazego_callback_latency_seconds"), []string{
+		`blazego_callback_latency_seconds_bucket{game="crash",le="0.0001"} 2`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="0.0005"} 2`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="0.001"} 2`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="0.005"} 4`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="0.01"} 4`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="0.05"} 4`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="0.1"} 4`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="0.5"} 4`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="1"} 4`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="5"} 4`,
+		`blazego_callback_latency_seconds_bucket{game="crash",le="+Inf"} 5`,
+		`blazego_callback_latency_seconds_sum{game="crash"} 10.00615`,
+		`blazego_callback_latency_seconds_count{game="crash"} 5`,
+	})
+}
+
+func TestMetricsLabelEscaping(t *testing.T) {
+	metrics := NewMetrics()
+	connection := newConnectionMetrics(metrics, "crash")
+
+	connection.event("a\"b\\c\nd")
+	connection.event("crash.tick")
+
+	equalLines(t, metricLines(t, metrics, "blazego_events_total"), []string{
+		`blazego_events_total{game="crash",event="a\"b\\c\nd"} 1`,
+		`blazego_events_total{game="crash",event="crash.tick"} 1`,
+	})
+}
+
+func TestMetricsSharedConnections(t *testing.T) {
+	metrics := NewMetrics()
+	first := newConnectionMetrics(metrics, "crash")
+	second := newConnectionMetrics(metrics, "crash")
+
+	first.opened()
+	second.opened()
+	first.queueDepth(3)
+	second.queueDepth(2)
+	equalLines(t, metricLines(t, metrics, "blazego_connected"), []string{`blazego_connected{game="crash"} 2`})
+	equalLines(t, metricLines(t, metrics, "blazego_reconnects_total"), []string{})
+	equalLines(t, metricLines(t, metrics, "blazego_callback_queue_depth"), []string{`blazego_callback_queue_depth{game="crash"} 5`})
+
+	// fechar duas vezes não desconta a outra conexão
+	first.closed()
+	first.closed()
+	first.queueDepth(0)
+	equalLines(t, metricLines(t, metrics, "blazego_connected"), []string{`blazego_connected{game="crash"} 1`})
+	equalLines(t, metricLines(t, metrics, "blazego_callback_queue_depth"), []string{`blazego_callback_queue_depth{game="crash"} 2`})
+
+	first.opened()
+	equalLines(t, metricLines(t, metrics, "blazego_connected"), []string{`blazego_connected{game="crash"} 2`})
+	equalLines(t, metricLines(t, metrics, "blazego_connections_total"), []string{`blazego_connections_total{game="crash"} 3`})
+	equalLines(t, metricLines(t, metrics, "blazego_reconnects_total"), []string{`blazego_reconnects_total{game="crash"} 1`})
+}
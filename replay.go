@@ -0,0 +1,309 @@
+package blazego
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/viniciusgdr/blazego/internal/emitter"
+)
+
+// ReplayOptions configura a reprodução de uma sessão gravada pelo SessionRecorder
+type ReplayOptions struct {
+	Path   string         // arquivo gravado (.jsonl ou .jsonl.gz), lido com os arquivos rotacionados por LoadSessionSet
+	Frames []SessionFrame // frames em memória, usados quando Path estiver vazio
+	// Speed multiplica a velocidade original (1 = tempo real, 4 = 4x); 0 reproduz o mais rápido possível
+	Speed float64
+	// Room reproduz apenas os frames da sala informada; MakeConnection usa a sala do jogo quando vazio
+	Room string
+	// Start segura a reprodução até ser fechado, para que os callbacks sejam registrados antes do
+	// primeiro frame (veja DeferReplayStart); sem ele a reprodução começa logo após o Connect
+	Start <-chan struct{}
+	// StartDelay é a espera antes do primeiro frame, contada a partir de Start (0 para nenhuma)
+	StartDelay time.Duration
+}
+
+// ReplayConnectionSocket implementa ConnectionSocket reproduzindo frames gravados no lugar da Blaze
+type ReplayConnectionSocket struct {
+	mu      sync.Mutex
+	options ReplayOptions
+	events  *emitter.Emitter
+	stop    chan struct{}
+}
+
+func NewReplayConnectionSocket(options ReplayOptions) *ReplayConnectionSocket {
+	return &ReplayConnectionSocket{
+		options: options,
+		events:  emitter.New(),
+	}
+}
+
+func (r *ReplayConnectionSocket) Connect(options ConnectionSocketOptions) error {
+	frames := r.options.Frames
+	if r.options.Path != "" {
+		loaded, err := LoadSessionSet(r.options.Path)
+		if err != nil {
+			return err
+		}
+		frames = loaded
+	}
+
+	if r.options.Room != "" {
+		filtered := make([]SessionFrame, 0, len(frames))
+		for _, frame := range frames {
+			if frame.Room == "" || frame.Room == r.options.Room {
+				filtered = append(filtered, frame)
+			}
+		}
+		frames = filtered
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stop != nil {
+		return errors.New("replay already connected")
+	}
+	r.stop = make(chan struct{})
+
+	go r.play(frames, r.stop)
+
+	r.emit("open", nil)
+
+	return nil
+}
+
+func (r *ReplayConnectionSocket) play(frames []SessionFrame, stop chan struct{}) {
+	defer r.emit("close", 1000)
+
+	if r.options.Start != nil {
+		select {
+		case <-r.options.Start:
+		case <-stop:
+			return
+		}
+	}
+
+	if !r.wait(r.options.StartDelay, stop) {
+		return
+	}
+
+	for i, frame := range frames {
+		if i > 0 && r.options.Speed > 0 {
+			delay := time.Duration(frame.Monotonic - frames[i-1].Monotonic)
+			if delay < 0 {
+				delay = frame.Time.Sub(frames[i-1].Time)
+			}
+
+			if !r.wait(time.Duration(float64(delay)/r.options.Speed), stop) {
+				return
+			}
+		}
+
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		r.emit("message", []byte(frame.Data))
+	}
+
+	r.mu.Lock()
+	if r.stop == stop {
+		r.stop = nil
+	}
+	r.mu.Unlock()
+}
+
+func (r *ReplayConnectionSocket) wait(delay time.Duration, stop chan struct{}) bool {
+	if delay <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-stop:
+		return false
+	}
+}
+
+func (r *ReplayConnectionSocket) On(event string, callback func(data interface{})) {
+	r.events.On(event, callback)
+}
+
+func (r *ReplayConnectionSocket) emit(event string, data interface{}) {
+	r.events.Emit(event, data)
+}
+
+func (r *ReplayConnectionSocket) Emit(event string, data interface{}) {
+	r.emit(event, data)
+}
+
+// Send descarta os comandos enviados, já que a sessão gravada não responde a eles
+func (r *ReplayConnectionSocket) Send(data interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stop == nil {
+		return errors.New("missing socket")
+	}
+	return nil
+}
+
+func (r *ReplayConnectionSocket) Disconnect() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stop == nil {
+		return errors.New("missing socket")
+	}
+
+	close(r.stop)
+	r.stop = nil
+	return nil
+}
+
+// DeferReplayStart faz a reprodução de conn esperar pela função retornada, que deve ser chamada
+// depois de registrar os callbacks. Sem Replay, ou com Start já definido, a função não faz nada.
+func DeferReplayStart(conn Connection) (Connection, func()) {
+	if conn.Replay == nil || conn.Replay.Start != nil {
+		return conn, func() {}
+	}
+
+	start := make(chan struct{})
+	replay := *conn.Replay
+	replay.Start = start
+	conn.Replay = &replay
+
+	var once sync.Once
+	return conn, func() {
+		once.Do(func() { close(start) })
+	}
+}
+
+// LoadSessionSet lê a sessão completa de path: os arquivos rotacionados pelo SessionRecorder
+// (base-<horário>.jsonl[.gz]) ou numerados (path.1, path.2.gz, ...), do mais antigo ao mais novo,
+// seguidos do próprio path
+func LoadSessionSet(path string) ([]SessionFrame, error) {
+	paths, err := sessionSetPaths(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(path); err == nil || len(paths) == 0 {
+		paths = append(paths, path)
+	}
+
+	frames := []SessionFrame{}
+	for _, file := range paths {
+		loaded, err := LoadSessionFrames(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		frames = append(frames, loaded...)
+	}
+	return frames, nil
+}
+
+// sessionSetPaths retorna os arquivos rotacionados de path em ordem cronológica
+func sessionSetPaths(path string) ([]string, error) {
+	type rotatedFile struct {
+		path  string
+		order string
+	}
+
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+
+	var numbered, timestamped []rotatedFile
+	for _, pattern := range []string{path + ".*", base + "-*" + ext, base + "-*" + ext + ".gz"} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, match := range matches {
+			if suffix, ok := strings.CutPrefix(match, path+"."); ok {
+				// logrotate: path.1 é o mais novo
+				if n, err := strconv.Atoi(strings.TrimSuffix(suffix, ".gz")); err == nil && n > 0 {
+					numbered = append(numbered, rotatedFile{path: match, order: fmt.Sprintf("%020d", n)})
+				}
+				continue
+			}
+
+			stamp := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(match, base+"-"), ".gz"), ext)
+			if _, err := time.Parse("20060102T150405.000000000", stamp); err == nil {
+				timestamped = append(timestamped, rotatedFile{path: match, order: stamp})
+			}
+		}
+	}
+
+	slices.SortFunc(numbered, func(a, b rotatedFile) int { return strings.Compare(b.order, a.order) })
+	slices.SortFunc(timestamped, func(a, b rotatedFile) int { return strings.Compare(a.order, b.order) })
+
+	paths := []string{}
+	for _, file := range append(numbered, timestamped...) {
+		paths = append(paths, file.path)
+	}
+	return slices.Compact(paths), nil
+}
+
+// LoadSessionFrames lê um arquivo gravado pelo SessionRecorder, compactado ou não
+func LoadSessionFrames(path string) ([]SessionFrame, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	return ReadSessionFrames(reader)
+}
+
+// ReadSessionFrames lê frames em JSON Lines, ignorando linhas vazias
+func ReadSessionFrames(r io.Reader) ([]SessionFrame, error) {
+	frames := []SessionFrame{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var frame SessionFrame
+		if err := json.Unmarshal(scanner.Bytes(), &frame); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		frames = append(frames, frame)
+	}
+
+	return frames, scanner.Err()
+}
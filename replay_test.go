@@ -0,0 +1,151 @@
+package blazego
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func crashTickFrame(id, status string) SessionFrame {
+	return SessionFrame{
+		Room: "crash_room_4",
+		Data: fmt.Sprintf(`42["data",{"id":"crash.tick","payload":{"id":%q,"status":%q}}]`, id, status),
+	}
+}
+
+func writeSessionFile(t *testing.T, path string, frames []SessionFrame) {
+	t.Helper()
+
+	recorder, err := NewSessionRecorder(RecorderOptions{Path: path, DisableCompression: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, frame := range frames {
+		if err := recorder.Record(frame); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func gzipFile(t *testing.T, path string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Create(path + ".gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer := gzip.NewWriter(file)
+	writer.Write(data)
+	writer.Close()
+	file.Close()
+	os.Remove(path)
+}
+
+func TestLoadSessionSet(t *testing.T) {
+	tests := []struct {
+		name string
+		// files em ordem cronológica; o último é o arquivo atual
+		files []string
+		gzip  map[string]bool
+	}{
+		{"single", []string{"session.jsonl"}, nil},
+		{"recorder", []string{"session-20261018T100000.000000000.jsonl", "session-20261018T110000.000000000.jsonl", "session.jsonl"},
+			map[string]bool{"session-20261018T100000.000000000.jsonl": true}},
+		{"logrotate", []string{"session.jsonl.2", "session.jsonl.1", "session.jsonl"},
+			map[string]bool{"session.jsonl.2": true}},
+		{"only rotated", []string{"session-20261018T100000.000000000.jsonl"}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			for i, name := range test.files {
+				path := filepath.Join(dir, name)
+				writeSessionFile(t, path, []SessionFrame{crashTickFrame(strconv.Itoa(i), "waiting")})
+				if test.gzip[name] {
+					gzipFile(t, path)
+				}
+			}
+			// arquivos de outra sessão não entram no conjunto
+			writeSessionFile(t, filepath.Join(dir, "session-other.jsonl"), []SessionFrame{crashTickFrame("other", "waiting")})
+
+			frames, err := LoadSessionSet(filepath.Join(dir, "session.jsonl"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(frames) != len(test.files) {
+				t.Fatalf("got %d frames, want %d", len(frames), len(test.files))
+			}
+			for i, frame := range frames {
+				if want := crashTickFrame(strconv.Itoa(i), "waiting").Data; frame.Data != want {
+					t.Errorf("frame %d = %s, want %s", i, frame.Data, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDeferReplayStart(t *testing.T) {
+	frames := []SessionFrame{}
+	for i := range 50 {
+		frames = append(frames, crashTickFrame(strconv.Itoa(i), "waiting"))
+	}
+
+	conn, start := DeferReplayStart(Connection{
+		GameType: "crash",
+		Web:      "blaze",
+		Replay:   &ReplayOptions{Frames: frames},
+	})
+
+	socket, err := MakeConnection(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer socket.Disconnect()
+
+	// nenhum frame pode ser perdido enquanto os callbacks ainda não foram registrados
+	time.Sleep(20 * time.Millisecond)
+
+	ticks := make(chan string, len(frames))
+	closed := make(chan struct{})
+	socket.On("crash.tick", func(data interface{}) {
+		tick, err := DecodeEvent[CrashTickEvent](data)
+		if err == nil {
+			ticks <- tick.ID
+		}
+	})
+	socket.On("close", func(data interface{}) {
+		close(closed)
+	})
+	start()
+	start()
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("replay did not finish")
+	}
+
+	if len(ticks) != len(frames) {
+		t.Fatalf("got %d ticks, want %d", len(ticks), len(frames))
+	}
+	for i := range frames {
+		if id := <-ticks; id != strconv.Itoa(i) {
+			t.Fatalf("tick %d = %s", i, id)
+		}
+	}
+}
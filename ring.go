@@ -0,0 +1,63 @@
+package blazego
+
+type ringBuffer[T any] struct {
+	items []T
+	start int
+	size  int
+}
+
+func newRingBuffer[T any](capacity int) *ringBuffer[T] {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &ringBuffer[T]{
+		items: make([]T, capacity),
+	}
+}
+
+// push adiciona um item e retorna o item removido quando o buffer já estava cheio
+func (r *ringBuffer[T]) push(item T) (T, bool) {
+	var evicted T
+
+	if r.size < len(r.items) {
+		r.items[(r.start+r.size)%len(r.items)] = item
+		r.size++
+		return evicted, false
+	}
+
+	evicted = r.items[r.start]
+	r.items[r.start] = item
+	r.start = (r.start + 1) % len(r.items)
+	return evicted, true
+}
+
+func (r *ringBuffer[T]) len() int {
+	return r.size
+}
+
+func (r *ringBuffer[T]) capacity() int {
+	return len(r.items)
+}
+
+// at retorna o item na posição i, sendo 0 o mais antigo
+func (r *ringBuffer[T]) at(i int) T {
+	return r.items[(r.start+i)%len(r.items)]
+}
+
+// set substitui o item na posição i, sendo 0 o mais antigo
+func (r *ringBuffer[T]) set(i int, item T) {
+	r.items[(r.start+i)%len(r.items)] = item
+}
+
+// last retorna os n itens mais recentes em ordem cronológica
+func (r *ringBuffer[T]) last(n int) []T {
+	if n > r.size || n < 0 {
+		n = r.size
+	}
+
+	result := make([]T, n)
+	for i := range n {
+		result[i] = r.at(r.size - n + i)
+	}
+	return result
+}
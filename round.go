@@ -0,0 +1,258 @@
+package blazego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Round representa uma rodada completa (do status "waiting" até "complete") com todos os ticks recebidos
+type Round struct {
+	Game        string            `json:"game"`
+	ID          string            `json:"id"`
+	CrashTicks  []CrashTickEvent  `json:"crash_ticks,omitempty"`
+	DoubleTicks []DoubleTickEvent `json:"double_ticks,omitempty"`
+	StartedAt   time.Time         `json:"started_at"`
+	CompletedAt time.Time         `json:"completed_at"`
+}
+
+// CrashPoint retorna o multiplicador final de uma rodada do crash
+func (r Round) CrashPoint() (float64, error) {
+	if len(r.CrashTicks) == 0 {
+		return 0, fmt.Errorf("round %s has no crash ticks", r.ID)
+	}
+
+	last := r.CrashTicks[len(r.CrashTicks)-1]
+	if last.CrashPoint == nil {
+		return 0, fmt.Errorf("missing crash point for round %s", r.ID)
+	}
+	return float64(*last.CrashPoint), nil
+}
+
+// DoubleResult retorna o número sorteado e a cor de uma rodada do double
+func (r Round) DoubleResult() (int, DoubleColor, error) {
+	if len(r.DoubleTicks) == 0 {
+		return 0, 0, fmt.Errorf("round %s has no double ticks", r.ID)
+	}
+	return doubleResult(r.DoubleTicks[len(r.DoubleTicks)-1])
+}
+
+func (r *Round) add(tick roundTick) {
+	switch event := tick.event.(type) {
+	case CrashTickEvent:
+		r.CrashTicks = append(r.CrashTicks, event)
+	case DoubleTickEvent:
+		r.DoubleTicks = append(r.DoubleTicks, event)
+	}
+}
+
+type roundTick struct {
+	id     string
+	status string
+	event  any
+}
+
+// TickEventForGame retorna o nome do evento de tick emitido para o tipo de jogo
+func TickEventForGame(game string) (string, error) {
+	switch game {
+	case "crash", "crash_2", "crash_neymarjr":
+		return "crash.tick", nil
+	case "doubles":
+		return "double.tick", nil
+	default:
+		return "", fmt.Errorf("unknown game %q", game)
+	}
+}
+
+func decodeRoundTick(tickEvent string, data interface{}) (roundTick, error) {
+	switch tickEvent {
+	case "crash.tick":
+		event, err := DecodeEvent[CrashTickEvent](data)
+		return roundTick{id: event.ID, status: event.Status, event: event}, err
+	case "double.tick":
+		event, err := DecodeEvent[DoubleTickEvent](data)
+		return roundTick{id: event.ID, status: event.Status, event: event}, err
+	default:
+		return roundTick{}, fmt.Errorf("unknown tick event %q", tickEvent)
+	}
+}
+
+// GetNextRound aguarda o próximo jogo completo e retorna a rodada com todos os ticks
+func GetNextRound(ctx context.Context, game string) (Round, error) {
+	return GetNextConnectionRound(ctx, Connection{GameType: game, Web: "blaze"})
+}
+
+// GetNextConnectionRound funciona como GetNextRound usando as opções de conexão informadas
+func GetNextConnectionRound(ctx context.Context, conn Connection) (Round, error) {
+	rounds, err := CollectConnectionRounds(ctx, conn, 1)
+	if err != nil {
+		return Round{}, err
+	}
+	return rounds[0], nil
+}
+
+// CollectRounds coleta n rodadas completas usando uma única conexão
+func CollectRounds(ctx context.Context, game string, n int) ([]Round, error) {
+	return CollectConnectionRounds(ctx, Connection{GameType: game, Web: "blaze"}, n)
+}
+
+// CollectConnectionRounds funciona como CollectRounds usando as opções de conexão informadas
+func CollectConnectionRounds(ctx context.Context, conn Connection, n int) ([]Round, error) {
+	if n <= 0 {
+		return nil, errors.New("n must be positive")
+	}
+
+	rounds := make([]Round, 0, n)
+	err := watchRounds(ctx, conn, n, func(round *Round, tick roundTick) error {
+		if tick.status == "complete" {
+			rounds = append(rounds, *round)
+		}
+		return nil
+	})
+	return rounds, err
+}
+
+// StreamRounds envia cada rodada completa no canal retornado até o contexto ser cancelado.
+// O canal de erro recebe no máximo um erro e ambos os canais são fechados ao final.
+func StreamRounds(ctx context.Context, game string) (<-chan Round, <-chan error) {
+	return StreamConnectionRounds(ctx, Connection{GameType: game, Web: "blaze"})
+}
+
+// StreamConnectionRounds funciona como StreamRounds usando as opções de conexão informadas
+func StreamConnectionRounds(ctx context.Context, conn Connection) (<-chan Round, <-chan error) {
+	roundChan := make(chan Round)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(roundChan)
+		defer close(errorChan)
+
+		err := watchRounds(ctx, conn, 0, func(round *Round, tick roundTick) error {
+			if tick.status != "complete" {
+				return nil
+			}
+
+			select {
+			case roundChan <- *round:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errorChan <- err
+		}
+	}()
+
+	return roundChan, errorChan
+}
+
+// watchRounds conecta ao jogo e chama handle para cada tick que pertence a uma rodada acompanhada
+// desde o status "waiting". Retorna nil depois de limit rodadas completas (0 para sem limite).
+func watchRounds(ctx context.Context, conn Connection, limit int, handle func(round *Round, tick roundTick) error) error {
+	tickEvent, err := TickEventForGame(conn.GameType)
+	if err != nil {
+		return err
+	}
+
+	conn, start := DeferReplayStart(conn)
+	socket, err := MakeConnection(conn)
+	if err != nil {
+		return fmt.Errorf("erro ao conectar: %w", err)
+	}
+	defer socket.Disconnect()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	ticks := make(chan roundTick, 16)
+	closes := make(chan CloseEvent, 1)
+
+	socket.On(tickEvent, func(data interface{}) {
+		tick, err := decodeRoundTick(tickEvent, data)
+		if err != nil || tick.id == "" {
+			return
+		}
+
+		select {
+		case ticks <- tick:
+		case <-done:
+		}
+	})
+
+	socket.On("close", func(data interface{}) {
+		closeEvent, _ := data.(CloseEvent)
+
+		select {
+		case closes <- closeEvent:
+		case <-done:
+		}
+	})
+	start()
+
+	var current *Round
+	completed := 0
+
+	// process retorna true quando o limite de rodadas foi atingido
+	process := func(tick roundTick) (bool, error) {
+		if tick.status == "waiting" && (current == nil || current.ID != tick.id) {
+			current = &Round{
+				Game:      conn.GameType,
+				ID:        tick.id,
+				StartedAt: time.Now(),
+			}
+		}
+
+		if current == nil || current.ID != tick.id {
+			return false, nil
+		}
+
+		current.add(tick)
+		if tick.status == "complete" {
+			current.CompletedAt = time.Now()
+		}
+
+		if err := handle(current, tick); err != nil {
+			return false, err
+		}
+
+		if tick.status == "complete" {
+			current = nil
+			completed++
+		}
+
+		return limit > 0 && completed >= limit, nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case closeEvent := <-closes:
+			// os ticks recebidos antes do fechamento ainda podem completar uma rodada
+			for pending := true; pending; {
+				select {
+				case tick := <-ticks:
+					if done, err := process(tick); done || err != nil {
+						return err
+					}
+				default:
+					pending = false
+				}
+			}
+
+			if closeEvent.Reconnect {
+				current = nil
+				continue
+			}
+			return fmt.Errorf("connection closed with code %d", closeEvent.Code)
+
+		case tick := <-ticks:
+			if done, err := process(tick); done || err != nil {
+				return err
+			}
+		}
+	}
+}
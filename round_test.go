@@ -0,0 +1,85 @@
+package blazego_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func TestTickEventForGame(t *testing.T) {
+	tests := []struct {
+		game    string
+		want    string
+		wantErr bool
+	}{
+		{"crash", "crash.tick", false},
+		{"crash_2", "crash.tick", false},
+		{"crash_neymarjr", "crash.tick", false},
+		{"doubles", "double.tick", false},
+		{"roleta", "", true},
+	}
+
+	for _, test := range tests {
+		event, err := blazego.TickEventForGame(test.game)
+		if (err != nil) != test.wantErr || event != test.want {
+			t.Errorf("TickEventForGame(%q) = %q, %v", test.game, event, err)
+		}
+	}
+}
+
+func TestRoundCrashPoint(t *testing.T) {
+	crashPoint := blazego.Float64String(2.5)
+	round := blazego.Round{
+		Game: "crash",
+		ID:   "c1",
+		CrashTicks: []blazego.CrashTickEvent{
+			{ID: "c1", Status: "waiting"},
+			{ID: "c1", Status: "graphing"},
+			{ID: "c1", Status: "complete", CrashPoint: &crashPoint},
+		},
+	}
+
+	point, err := round.CrashPoint()
+	if err != nil || point != 2.5 {
+		t.Errorf("CrashPoint() = %v, %v", point, err)
+	}
+
+	round.CrashTicks = round.CrashTicks[:2]
+	if _, err := round.CrashPoint(); err == nil {
+		t.Error("expected error for round without crash point")
+	}
+	if _, err := (blazego.Round{ID: "c2"}).CrashPoint(); err == nil {
+		t.Error("expected error for round without ticks")
+	}
+}
+
+func TestRoundDoubleResult(t *testing.T) {
+	roll := blazego.StringOrNumber("0")
+	round := blazego.Round{
+		Game: "doubles",
+		ID:   "d1",
+		DoubleTicks: []blazego.DoubleTickEvent{
+			{ID: "d1", Status: "waiting"},
+			{ID: "d1", Status: "complete", Roll: &roll},
+		},
+	}
+
+	number, color, err := round.DoubleResult()
+	if err != nil || number != 0 || color != blazego.DoubleColorWhite {
+		t.Errorf("DoubleResult() = %d, %v, %v", number, color, err)
+	}
+
+	if _, _, err := (blazego.Round{ID: "d2"}).DoubleResult(); err == nil {
+		t.Error("expected error for round without ticks")
+	}
+}
+
+func TestCollectRoundsInvalid(t *testing.T) {
+	if _, err := blazego.CollectRounds(context.Background(), "crash", 0); err == nil {
+		t.Error("expected error for n = 0")
+	}
+	if _, err := blazego.CollectRounds(context.Background(), "roleta", 1); err == nil {
+		t.Error("expected error for unknown game")
+	}
+}
@@ -0,0 +1,1389 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: blazepb/blaze.proto
+
+package blazepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DoubleColor int32
+
+const (
+	DoubleColor_DOUBLE_COLOR_UNSPECIFIED DoubleColor = 0
+	DoubleColor_DOUBLE_COLOR_WHITE       DoubleColor = 1
+	DoubleColor_DOUBLE_COLOR_RED         DoubleColor = 2
+	DoubleColor_DOUBLE_COLOR_BLACK       DoubleColor = 3
+)
+
+// Enum value maps for DoubleColor.
+var (
+	DoubleColor_name = map[int32]string{
+		0: "DOUBLE_COLOR_UNSPECIFIED",
+		1: "DOUBLE_COLOR_WHITE",
+		2: "DOUBLE_COLOR_RED",
+		3: "DOUBLE_COLOR_BLACK",
+	}
+	DoubleColor_value = map[string]int32{
+		"DOUBLE_COLOR_UNSPECIFIED": 0,
+		"DOUBLE_COLOR_WHITE":       1,
+		"DOUBLE_COLOR_RED":         2,
+		"DOUBLE_COLOR_BLACK":       3,
+	}
+)
+
+func (x DoubleColor) Enum() *DoubleColor {
+	p := new(DoubleColor)
+	*p = x
+	return p
+}
+
+func (x DoubleColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DoubleColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_blazepb_blaze_proto_enumTypes[0].Descriptor()
+}
+
+func (DoubleColor) Type() protoreflect.EnumType {
+	return &file_blazepb_blaze_proto_enumTypes[0]
+}
+
+func (x DoubleColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DoubleColor.Descriptor instead.
+func (DoubleColor) EnumDescriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{0}
+}
+
+type SubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Jogos assinados ("crash", "crash_2", "crash_neymarjr", "doubles", "chat"); vazio assina todos.
+	Games []string `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	// Tipos de evento ("crash.tick", "crash.tick-bets", "double.tick", "chat.message", "close"); vazio aceita todos.
+	Types         []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	LastEventId   *uint64  `protobuf:"varint,3,opt,name=last_event_id,json=lastEventId,proto3,oneof" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_blazepb_blaze_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetGames() []string {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetLastEventId() uint64 {
+	if x != nil && x.LastEventId != nil {
+		return *x.LastEventId
+	}
+	return 0
+}
+
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Game  string                 `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	Type  string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Time  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Event_CrashTick
+	//	*Event_CrashTickBets
+	//	*Event_DoubleTick
+	//	*Event_ChatMessage
+	//	*Event_Close
+	Payload       isEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_blazepb_blaze_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Event) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetPayload() isEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetCrashTick() *CrashTick {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_CrashTick); ok {
+			return x.CrashTick
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetCrashTickBets() *CrashTickBets {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_CrashTickBets); ok {
+			return x.CrashTickBets
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetDoubleTick() *DoubleTick {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_DoubleTick); ok {
+			return x.DoubleTick
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChatMessage() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ChatMessage); ok {
+			return x.ChatMessage
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetClose() *CloseEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Close); ok {
+			return x.Close
+		}
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_CrashTick struct {
+	CrashTick *CrashTick `protobuf:"bytes,5,opt,name=crash_tick,json=crashTick,proto3,oneof"`
+}
+
+type Event_CrashTickBets struct {
+	CrashTickBets *CrashTickBets `protobuf:"bytes,6,opt,name=crash_tick_bets,json=crashTickBets,proto3,oneof"`
+}
+
+type Event_DoubleTick struct {
+	DoubleTick *DoubleTick `protobuf:"bytes,7,opt,name=double_tick,json=doubleTick,proto3,oneof"`
+}
+
+type Event_ChatMessage struct {
+	ChatMessage *ChatMessage `protobuf:"bytes,8,opt,name=chat_message,json=chatMessage,proto3,oneof"`
+}
+
+type Event_Close struct {
+	Close *CloseEvent `protobuf:"bytes,9,opt,name=close,proto3,oneof"`
+}
+
+func (*Event_CrashTick) isEvent_Payload() {}
+
+func (*Event_CrashTickBets) isEvent_Payload() {}
+
+func (*Event_DoubleTick) isEvent_Payload() {}
+
+func (*Event_ChatMessage) isEvent_Payload() {}
+
+func (*Event_Close) isEvent_Payload() {}
+
+type CrashTick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CrashPoint    *float64               `protobuf:"fixed64,4,opt,name=crash_point,json=crashPoint,proto3,oneof" json:"crash_point,omitempty"`
+	IsBonusRound  bool                   `protobuf:"varint,5,opt,name=is_bonus_round,json=isBonusRound,proto3" json:"is_bonus_round,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrashTick) Reset() {
+	*x = CrashTick{}
+	mi := &file_blazepb_blaze_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrashTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashTick) ProtoMessage() {}
+
+func (x *CrashTick) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashTick.ProtoReflect.Descriptor instead.
+func (*CrashTick) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CrashTick) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CrashTick) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *CrashTick) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CrashTick) GetCrashPoint() float64 {
+	if x != nil && x.CrashPoint != nil {
+		return *x.CrashPoint
+	}
+	return 0
+}
+
+func (x *CrashTick) GetIsBonusRound() bool {
+	if x != nil {
+		return x.IsBonusRound
+	}
+	return false
+}
+
+type Bet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CashedOutAt   *float64               `protobuf:"fixed64,2,opt,name=cashed_out_at,json=cashedOutAt,proto3,oneof" json:"cashed_out_at,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrencyType  string                 `protobuf:"bytes,4,opt,name=currency_type,json=currencyType,proto3" json:"currency_type,omitempty"`
+	WinAmount     string                 `protobuf:"bytes,5,opt,name=win_amount,json=winAmount,proto3" json:"win_amount,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bet) Reset() {
+	*x = Bet{}
+	mi := &file_blazepb_blaze_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bet) ProtoMessage() {}
+
+func (x *Bet) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bet.ProtoReflect.Descriptor instead.
+func (*Bet) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Bet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bet) GetCashedOutAt() float64 {
+	if x != nil && x.CashedOutAt != nil {
+		return *x.CashedOutAt
+	}
+	return 0
+}
+
+func (x *Bet) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Bet) GetCurrencyType() string {
+	if x != nil {
+		return x.CurrencyType
+	}
+	return ""
+}
+
+func (x *Bet) GetWinAmount() string {
+	if x != nil {
+		return x.WinAmount
+	}
+	return ""
+}
+
+func (x *Bet) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CrashTickBets struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId          int32                  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	TotalEurBet     float64                `protobuf:"fixed64,3,opt,name=total_eur_bet,json=totalEurBet,proto3" json:"total_eur_bet,omitempty"`
+	TotalBetsPlaced string                 `protobuf:"bytes,4,opt,name=total_bets_placed,json=totalBetsPlaced,proto3" json:"total_bets_placed,omitempty"`
+	TotalEurWon     float64                `protobuf:"fixed64,5,opt,name=total_eur_won,json=totalEurWon,proto3" json:"total_eur_won,omitempty"`
+	Bets            []*Bet                 `protobuf:"bytes,6,rep,name=bets,proto3" json:"bets,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CrashTickBets) Reset() {
+	*x = CrashTickBets{}
+	mi := &file_blazepb_blaze_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrashTickBets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashTickBets) ProtoMessage() {}
+
+func (x *CrashTickBets) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashTickBets.ProtoReflect.Descriptor instead.
+func (*CrashTickBets) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CrashTickBets) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CrashTickBets) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CrashTickBets) GetTotalEurBet() float64 {
+	if x != nil {
+		return x.TotalEurBet
+	}
+	return 0
+}
+
+func (x *CrashTickBets) GetTotalBetsPlaced() string {
+	if x != nil {
+		return x.TotalBetsPlaced
+	}
+	return ""
+}
+
+func (x *CrashTickBets) GetTotalEurWon() float64 {
+	if x != nil {
+		return x.TotalEurWon
+	}
+	return 0
+}
+
+func (x *CrashTickBets) GetBets() []*Bet {
+	if x != nil {
+		return x.Bets
+	}
+	return nil
+}
+
+type DoubleTick struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Cor e número sorteado, presentes quando o status for "complete".
+	Color                DoubleColor `protobuf:"varint,2,opt,name=color,proto3,enum=blazego.v1.DoubleColor" json:"color,omitempty"`
+	Roll                 *int32      `protobuf:"varint,3,opt,name=roll,proto3,oneof" json:"roll,omitempty"`
+	CreatedAt            string      `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string      `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status               string      `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	TotalRedEurBet       float64     `protobuf:"fixed64,7,opt,name=total_red_eur_bet,json=totalRedEurBet,proto3" json:"total_red_eur_bet,omitempty"`
+	TotalRedBetsPlaced   int32       `protobuf:"varint,8,opt,name=total_red_bets_placed,json=totalRedBetsPlaced,proto3" json:"total_red_bets_placed,omitempty"`
+	TotalWhiteEurBet     float64     `protobuf:"fixed64,9,opt,name=total_white_eur_bet,json=totalWhiteEurBet,proto3" json:"total_white_eur_bet,omitempty"`
+	TotalWhiteBetsPlaced int32       `protobuf:"varint,10,opt,name=total_white_bets_placed,json=totalWhiteBetsPlaced,proto3" json:"total_white_bets_placed,omitempty"`
+	TotalBlackEurBet     float64     `protobuf:"fixed64,11,opt,name=total_black_eur_bet,json=totalBlackEurBet,proto3" json:"total_black_eur_bet,omitempty"`
+	TotalBlackBetsPlaced int32       `protobuf:"varint,12,opt,name=total_black_bets_placed,json=totalBlackBetsPlaced,proto3" json:"total_black_bets_placed,omitempty"`
+	Bets                 []*Bet      `protobuf:"bytes,13,rep,name=bets,proto3" json:"bets,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DoubleTick) Reset() {
+	*x = DoubleTick{}
+	mi := &file_blazepb_blaze_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleTick) ProtoMessage() {}
+
+func (x *DoubleTick) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleTick.ProtoReflect.Descriptor instead.
+func (*DoubleTick) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DoubleTick) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DoubleTick) GetColor() DoubleColor {
+	if x != nil {
+		return x.Color
+	}
+	return DoubleColor_DOUBLE_COLOR_UNSPECIFIED
+}
+
+func (x *DoubleTick) GetRoll() int32 {
+	if x != nil && x.Roll != nil {
+		return *x.Roll
+	}
+	return 0
+}
+
+func (x *DoubleTick) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DoubleTick) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *DoubleTick) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DoubleTick) GetTotalRedEurBet() float64 {
+	if x != nil {
+		return x.TotalRedEurBet
+	}
+	return 0
+}
+
+func (x *DoubleTick) GetTotalRedBetsPlaced() int32 {
+	if x != nil {
+		return x.TotalRedBetsPlaced
+	}
+	return 0
+}
+
+func (x *DoubleTick) GetTotalWhiteEurBet() float64 {
+	if x != nil {
+		return x.TotalWhiteEurBet
+	}
+	return 0
+}
+
+func (x *DoubleTick) GetTotalWhiteBetsPlaced() int32 {
+	if x != nil {
+		return x.TotalWhiteBetsPlaced
+	}
+	return 0
+}
+
+func (x *DoubleTick) GetTotalBlackEurBet() float64 {
+	if x != nil {
+		return x.TotalBlackEurBet
+	}
+	return 0
+}
+
+func (x *DoubleTick) GetTotalBlackBetsPlaced() int32 {
+	if x != nil {
+		return x.TotalBlackBetsPlaced
+	}
+	return 0
+}
+
+func (x *DoubleTick) GetBets() []*Bet {
+	if x != nil {
+		return x.Bets
+	}
+	return nil
+}
+
+type ChatUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Rank          string                 `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Label         *string                `protobuf:"bytes,4,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Level         int32                  `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatUser) Reset() {
+	*x = ChatUser{}
+	mi := &file_blazepb_blaze_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatUser) ProtoMessage() {}
+
+func (x *ChatUser) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatUser.ProtoReflect.Descriptor instead.
+func (*ChatUser) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChatUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChatUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChatUser) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *ChatUser) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *ChatUser) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Available     bool                   `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	User          *ChatUser              `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_blazepb_blaze_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChatMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *ChatMessage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetUser() *ChatUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type CloseEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Reconnect     bool                   `protobuf:"varint,2,opt,name=reconnect,proto3" json:"reconnect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseEvent) Reset() {
+	*x = CloseEvent{}
+	mi := &file_blazepb_blaze_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseEvent) ProtoMessage() {}
+
+func (x *CloseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseEvent.ProtoReflect.Descriptor instead.
+func (*CloseEvent) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CloseEvent) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CloseEvent) GetReconnect() bool {
+	if x != nil {
+		return x.Reconnect
+	}
+	return false
+}
+
+type GetHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Game  string                 `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	// Quantidade de resultados (padrão 50).
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_blazepb_blaze_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetHistoryRequest) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Preenchido para os jogos do crash.
+	Crash []*CrashResult `protobuf:"bytes,1,rep,name=crash,proto3" json:"crash,omitempty"`
+	// Preenchido para o double.
+	Double        []*DoubleResult `protobuf:"bytes,2,rep,name=double,proto3" json:"double,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_blazepb_blaze_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetHistoryResponse) GetCrash() []*CrashResult {
+	if x != nil {
+		return x.Crash
+	}
+	return nil
+}
+
+func (x *GetHistoryResponse) GetDouble() []*DoubleResult {
+	if x != nil {
+		return x.Double
+	}
+	return nil
+}
+
+type CrashResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          string                 `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CrashPoint    float64                `protobuf:"fixed64,3,opt,name=crash_point,json=crashPoint,proto3" json:"crash_point,omitempty"`
+	IsBonusRound  bool                   `protobuf:"varint,4,opt,name=is_bonus_round,json=isBonusRound,proto3" json:"is_bonus_round,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrashResult) Reset() {
+	*x = CrashResult{}
+	mi := &file_blazepb_blaze_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrashResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashResult) ProtoMessage() {}
+
+func (x *CrashResult) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashResult.ProtoReflect.Descriptor instead.
+func (*CrashResult) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CrashResult) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *CrashResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CrashResult) GetCrashPoint() float64 {
+	if x != nil {
+		return x.CrashPoint
+	}
+	return 0
+}
+
+func (x *CrashResult) GetIsBonusRound() bool {
+	if x != nil {
+		return x.IsBonusRound
+	}
+	return false
+}
+
+func (x *CrashResult) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *CrashResult) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type DoubleResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Color         DoubleColor            `protobuf:"varint,2,opt,name=color,proto3,enum=blazego.v1.DoubleColor" json:"color,omitempty"`
+	Roll          int32                  `protobuf:"varint,3,opt,name=roll,proto3" json:"roll,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleResult) Reset() {
+	*x = DoubleResult{}
+	mi := &file_blazepb_blaze_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleResult) ProtoMessage() {}
+
+func (x *DoubleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_blazepb_blaze_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleResult.ProtoReflect.Descriptor instead.
+func (*DoubleResult) Descriptor() ([]byte, []int) {
+	return file_blazepb_blaze_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DoubleResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DoubleResult) GetColor() DoubleColor {
+	if x != nil {
+		return x.Color
+	}
+	return DoubleColor_DOUBLE_COLOR_UNSPECIFIED
+}
+
+func (x *DoubleResult) GetRoll() int32 {
+	if x != nil {
+		return x.Roll
+	}
+	return 0
+}
+
+func (x *DoubleResult) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DoubleResult) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *DoubleResult) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *DoubleResult) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+var File_blazepb_blaze_proto protoreflect.FileDescriptor
+
+const file_blazepb_blaze_proto_rawDesc = "" +
+	"\n" +
+	"\x13blazepb/blaze.proto\x12\n" +
+	"blazego.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"y\n" +
+	"\x10SubscribeRequest\x12\x14\n" +
+	"\x05games\x18\x01 \x03(\tR\x05games\x12\x14\n" +
+	"\x05types\x18\x02 \x03(\tR\x05types\x12'\n" +
+	"\rlast_event_id\x18\x03 \x01(\x04H\x00R\vlastEventId\x88\x01\x01B\x10\n" +
+	"\x0e_last_event_id\"\xa0\x03\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04game\x18\x02 \x01(\tR\x04game\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x126\n" +
+	"\n" +
+	"crash_tick\x18\x05 \x01(\v2\x15.blazego.v1.CrashTickH\x00R\tcrashTick\x12C\n" +
+	"\x0fcrash_tick_bets\x18\x06 \x01(\v2\x19.blazego.v1.CrashTickBetsH\x00R\rcrashTickBets\x129\n" +
+	"\vdouble_tick\x18\a \x01(\v2\x16.blazego.v1.DoubleTickH\x00R\n" +
+	"doubleTick\x12<\n" +
+	"\fchat_message\x18\b \x01(\v2\x17.blazego.v1.ChatMessageH\x00R\vchatMessage\x12.\n" +
+	"\x05close\x18\t \x01(\v2\x16.blazego.v1.CloseEventH\x00R\x05closeB\t\n" +
+	"\apayload\"\xae\x01\n" +
+	"\tCrashTick\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\tR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12$\n" +
+	"\vcrash_point\x18\x04 \x01(\x01H\x00R\n" +
+	"crashPoint\x88\x01\x01\x12$\n" +
+	"\x0eis_bonus_round\x18\x05 \x01(\bR\fisBonusRoundB\x0e\n" +
+	"\f_crash_point\"\xc4\x01\n" +
+	"\x03Bet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\rcashed_out_at\x18\x02 \x01(\x01H\x00R\vcashedOutAt\x88\x01\x01\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12#\n" +
+	"\rcurrency_type\x18\x04 \x01(\tR\fcurrencyType\x12\x1d\n" +
+	"\n" +
+	"win_amount\x18\x05 \x01(\tR\twinAmount\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06statusB\x10\n" +
+	"\x0e_cashed_out_at\"\xd1\x01\n" +
+	"\rCrashTickBets\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\x05R\x06roomId\x12\"\n" +
+	"\rtotal_eur_bet\x18\x03 \x01(\x01R\vtotalEurBet\x12*\n" +
+	"\x11total_bets_placed\x18\x04 \x01(\tR\x0ftotalBetsPlaced\x12\"\n" +
+	"\rtotal_eur_won\x18\x05 \x01(\x01R\vtotalEurWon\x12#\n" +
+	"\x04bets\x18\x06 \x03(\v2\x0f.blazego.v1.BetR\x04bets\"\x92\x04\n" +
+	"\n" +
+	"DoubleTick\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x05color\x18\x02 \x01(\x0e2\x17.blazego.v1.DoubleColorR\x05color\x12\x17\n" +
+	"\x04roll\x18\x03 \x01(\x05H\x00R\x04roll\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12)\n" +
+	"\x11total_red_eur_bet\x18\a \x01(\x01R\x0etotalRedEurBet\x121\n" +
+	"\x15total_red_bets_placed\x18\b \x01(\x05R\x12totalRedBetsPlaced\x12-\n" +
+	"\x13total_white_eur_bet\x18\t \x01(\x01R\x10totalWhiteEurBet\x125\n" +
+	"\x17total_white_bets_placed\x18\n" +
+	" \x01(\x05R\x14totalWhiteBetsPlaced\x12-\n" +
+	"\x13total_black_eur_bet\x18\v \x01(\x01R\x10totalBlackEurBet\x125\n" +
+	"\x17total_black_bets_placed\x18\f \x01(\x05R\x14totalBlackBetsPlaced\x12#\n" +
+	"\x04bets\x18\r \x03(\v2\x0f.blazego.v1.BetR\x04betsB\a\n" +
+	"\x05_roll\"\x85\x01\n" +
+	"\bChatUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\tR\x04rank\x12\x19\n" +
+	"\x05label\x18\x04 \x01(\tH\x00R\x05label\x88\x01\x01\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\x05R\x05levelB\b\n" +
+	"\x06_label\"\x98\x01\n" +
+	"\vChatMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\bR\tavailable\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12(\n" +
+	"\x04user\x18\x05 \x01(\v2\x14.blazego.v1.ChatUserR\x04user\">\n" +
+	"\n" +
+	"CloseEvent\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x1c\n" +
+	"\treconnect\x18\x02 \x01(\bR\treconnect\"=\n" +
+	"\x11GetHistoryRequest\x12\x12\n" +
+	"\x04game\x18\x01 \x01(\tR\x04game\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"u\n" +
+	"\x12GetHistoryResponse\x12-\n" +
+	"\x05crash\x18\x01 \x03(\v2\x17.blazego.v1.CrashResultR\x05crash\x120\n" +
+	"\x06double\x18\x02 \x03(\v2\x18.blazego.v1.DoubleResultR\x06double\"\xd6\x01\n" +
+	"\vCrashResult\x12\x12\n" +
+	"\x04game\x18\x01 \x01(\tR\x04game\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcrash_point\x18\x03 \x01(\x01R\n" +
+	"crashPoint\x12$\n" +
+	"\x0eis_bonus_round\x18\x04 \x01(\bR\fisBonusRound\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x99\x02\n" +
+	"\fDoubleResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x05color\x18\x02 \x01(\x0e2\x17.blazego.v1.DoubleColorR\x05color\x12\x12\n" +
+	"\x04roll\x18\x03 \x01(\x05R\x04roll\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt*q\n" +
+	"\vDoubleColor\x12\x1c\n" +
+	"\x18DOUBLE_COLOR_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12DOUBLE_COLOR_WHITE\x10\x01\x12\x14\n" +
+	"\x10DOUBLE_COLOR_RED\x10\x02\x12\x16\n" +
+	"\x12DOUBLE_COLOR_BLACK\x10\x032\x9b\x01\n" +
+	"\fBlazeService\x12>\n" +
+	"\tSubscribe\x12\x1c.blazego.v1.SubscribeRequest\x1a\x11.blazego.v1.Event0\x01\x12K\n" +
+	"\n" +
+	"GetHistory\x12\x1d.blazego.v1.GetHistoryRequest\x1a\x1e.blazego.v1.GetHistoryResponseB4Z2github.com/viniciusgdr/blazego/rpc/blazepb;blazepbb\x06proto3"
+
+var (
+	file_blazepb_blaze_proto_rawDescOnce sync.Once
+	file_blazepb_blaze_proto_rawDescData []byte
+)
+
+func file_blazepb_blaze_proto_rawDescGZIP() []byte {
+	file_blazepb_blaze_proto_rawDescOnce.Do(func() {
+		file_blazepb_blaze_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_blazepb_blaze_proto_rawDesc), len(file_blazepb_blaze_proto_rawDesc)))
+	})
+	return file_blazepb_blaze_proto_rawDescData
+}
+
+var file_blazepb_blaze_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_blazepb_blaze_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_blazepb_blaze_proto_goTypes = []any{
+	(DoubleColor)(0),              // 0: blazego.v1.DoubleColor
+	(*SubscribeRequest)(nil),      // 1: blazego.v1.SubscribeRequest
+	(*Event)(nil),                 // 2: blazego.v1.Event
+	(*CrashTick)(nil),             // 3: blazego.v1.CrashTick
+	(*Bet)(nil),                   // 4: blazego.v1.Bet
+	(*CrashTickBets)(nil),         // 5: blazego.v1.CrashTickBets
+	(*DoubleTick)(nil),            // 6: blazego.v1.DoubleTick
+	(*ChatUser)(nil),              // 7: blazego.v1.ChatUser
+	(*ChatMessage)(nil),           // 8: blazego.v1.ChatMessage
+	(*CloseEvent)(nil),            // 9: blazego.v1.CloseEvent
+	(*GetHistoryRequest)(nil),     // 10: blazego.v1.GetHistoryRequest
+	(*GetHistoryResponse)(nil),    // 11: blazego.v1.GetHistoryResponse
+	(*CrashResult)(nil),           // 12: blazego.v1.CrashResult
+	(*DoubleResult)(nil),          // 13: blazego.v1.DoubleResult
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+}
+var file_blazepb_blaze_proto_depIdxs = []int32{
+	14, // 0: blazego.v1.Event.time:type_name -> google.protobuf.Timestamp
+	3,  // 1: blazego.v1.Event.crash_tick:type_name -> blazego.v1.CrashTick
+	5,  // 2: blazego.v1.Event.crash_tick_bets:type_name -> blazego.v1.CrashTickBets
+	6,  // 3: blazego.v1.Event.double_tick:type_name -> blazego.v1.DoubleTick
+	8,  // 4: blazego.v1.Event.chat_message:type_name -> blazego.v1.ChatMessage
+	9,  // 5: blazego.v1.Event.close:type_name -> blazego.v1.CloseEvent
+	4,  // 6: blazego.v1.CrashTickBets.bets:type_name -> blazego.v1.Bet
+	0,  // 7: blazego.v1.DoubleTick.color:type_name -> blazego.v1.DoubleColor
+	4,  // 8: blazego.v1.DoubleTick.bets:type_name -> blazego.v1.Bet
+	7,  // 9: blazego.v1.ChatMessage.user:type_name -> blazego.v1.ChatUser
+	12, // 10: blazego.v1.GetHistoryResponse.crash:type_name -> blazego.v1.CrashResult
+	13, // 11: blazego.v1.GetHistoryResponse.double:type_name -> blazego.v1.DoubleResult
+	14, // 12: blazego.v1.CrashResult.completed_at:type_name -> google.protobuf.Timestamp
+	0,  // 13: blazego.v1.DoubleResult.color:type_name -> blazego.v1.DoubleColor
+	14, // 14: blazego.v1.DoubleResult.started_at:type_name -> google.protobuf.Timestamp
+	14, // 15: blazego.v1.DoubleResult.completed_at:type_name -> google.protobuf.Timestamp
+	1,  // 16: blazego.v1.BlazeService.Subscribe:input_type -> blazego.v1.SubscribeRequest
+	10, // 17: blazego.v1.BlazeService.GetHistory:input_type -> blazego.v1.GetHistoryRequest
+	2,  // 18: blazego.v1.BlazeService.Subscribe:output_type -> blazego.v1.Event
+	11, // 19: blazego.v1.BlazeService.GetHistory:output_type -> blazego.v1.GetHistoryResponse
+	18, // [18:20] is the sub-list for method output_type
+	16, // [16:18] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_blazepb_blaze_proto_init() }
+func file_blazepb_blaze_proto_init() {
+	if File_blazepb_blaze_proto != nil {
+		return
+	}
+	file_blazepb_blaze_proto_msgTypes[0].OneofWrappers = []any{}
+	file_blazepb_blaze_proto_msgTypes[1].OneofWrappers = []any{
+		(*Event_CrashTick)(nil),
+		(*Event_CrashTickBets)(nil),
+		(*Event_DoubleTick)(nil),
+		(*Event_ChatMessage)(nil),
+		(*Event_Close)(nil),
+	}
+	file_blazepb_blaze_proto_msgTypes[2].OneofWrappers = []any{}
+	file_blazepb_blaze_proto_msgTypes[3].OneofWrappers = []any{}
+	file_blazepb_blaze_proto_msgTypes[5].OneofWrappers = []any{}
+	file_blazepb_blaze_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_blazepb_blaze_proto_rawDesc), len(file_blazepb_blaze_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_blazepb_blaze_proto_goTypes,
+		DependencyIndexes: file_blazepb_blaze_proto_depIdxs,
+		EnumInfos:         file_blazepb_blaze_proto_enumTypes,
+		MessageInfos:      file_blazepb_blaze_proto_msgTypes,
+	}.Build()
+	File_blazepb_blaze_proto = out.File
+	file_blazepb_blaze_proto_goTypes = nil
+	file_blazepb_blaze_proto_depIdxs = nil
+}
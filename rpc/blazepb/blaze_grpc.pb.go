@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: blazepb/blaze.proto
+
+package blazepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BlazeService_Subscribe_FullMethodName  = "/blazego.v1.BlazeService/Subscribe"
+	BlazeService_GetHistory_FullMethodName = "/blazego.v1.BlazeService/GetHistory"
+)
+
+// BlazeServiceClient is the client API for BlazeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BlazeService expõe os eventos e o histórico acompanhados pelo gateway.
+type BlazeServiceClient interface {
+	// Subscribe transmite os eventos dos jogos filtrados; last_event_id retoma o fluxo a partir do buffer.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	// GetHistory retorna os últimos resultados de um jogo em ordem cronológica.
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+}
+
+type blazeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlazeServiceClient(cc grpc.ClientConnInterface) BlazeServiceClient {
+	return &blazeServiceClient{cc}
+}
+
+func (c *blazeServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BlazeService_ServiceDesc.Streams[0], BlazeService_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BlazeService_SubscribeClient = grpc.ServerStreamingClient[Event]
+
+func (c *blazeServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, BlazeService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlazeServiceServer is the server API for BlazeService service.
+// All implementations must embed UnimplementedBlazeServiceServer
+// for forward compatibility.
+//
+// BlazeService expõe os eventos e o histórico acompanhados pelo gateway.
+type BlazeServiceServer interface {
+	// Subscribe transmite os eventos dos jogos filtrados; last_event_id retoma o fluxo a partir do buffer.
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Event]) error
+	// GetHistory retorna os últimos resultados de um jogo em ordem cronológica.
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	mustEmbedUnimplementedBlazeServiceServer()
+}
+
+// UnimplementedBlazeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlazeServiceServer struct{}
+
+func (UnimplementedBlazeServiceServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedBlazeServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedBlazeServiceServer) mustEmbedUnimplementedBlazeServiceServer() {}
+func (UnimplementedBlazeServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeBlazeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlazeServiceServer will
+// result in compilation errors.
+type UnsafeBlazeServiceServer interface {
+	mustEmbedUnimplementedBlazeServiceServer()
+}
+
+func RegisterBlazeServiceServer(s grpc.ServiceRegistrar, srv BlazeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBlazeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BlazeService_ServiceDesc, srv)
+}
+
+func _BlazeService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlazeServiceServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BlazeService_SubscribeServer = grpc.ServerStreamingServer[Event]
+
+func _BlazeService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlazeServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlazeService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlazeServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlazeService_ServiceDesc is the grpc.ServiceDesc for BlazeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlazeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blazego.v1.BlazeService",
+	HandlerType: (*BlazeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHistory",
+			Handler:    _BlazeService_GetHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _BlazeService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "blazepb/blaze.proto",
+}
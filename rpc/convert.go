@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/gateway"
+	"github.com/viniciusgdr/blazego/rpc/blazepb"
+)
+
+// eventMessage converte um evento do gateway na mensagem tipada do proto
+func eventMessage(event gateway.Event) (*blazepb.Event, error) {
+	payload, err := event.Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	message := &blazepb.Event{
+		Id:   event.ID,
+		Game: event.Game,
+		Type: event.Type,
+		Time: timestamppb.New(event.Time),
+	}
+
+	switch payload := payload.(type) {
+	case blazego.CrashTickEvent:
+		tick := &blazepb.CrashTick{
+			Id:           payload.ID,
+			UpdatedAt:    payload.UpdatedAt,
+			Status:       payload.Status,
+			IsBonusRound: payload.IsBonusRound,
+		}
+		if payload.CrashPoint != nil {
+			crashPoint := float64(*payload.CrashPoint)
+			tick.CrashPoint = &crashPoint
+		}
+		message.Payload = &blazepb.Event_CrashTick{CrashTick: tick}
+
+	case blazego.CrashTickBetsEvent:
+		message.Payload = &blazepb.Event_CrashTickBets{CrashTickBets: &blazepb.CrashTickBets{
+			Id:              payload.ID,
+			RoomId:          int32(payload.RoomID),
+			TotalEurBet:     payload.TotalEurBet,
+			TotalBetsPlaced: payload.TotalBetsPlaced,
+			TotalEurWon:     payload.TotalEurWon,
+			Bets:            bets(payload.Bets),
+		}}
+
+	case blazego.DoubleTickEvent:
+		tick := &blazepb.DoubleTick{
+			Id:                   payload.ID,
+			CreatedAt:            payload.CreatedAt,
+			UpdatedAt:            payload.UpdatedAt,
+			Status:               payload.Status,
+			TotalRedEurBet:       payload.TotalRedEurBet,
+			TotalRedBetsPlaced:   int32(payload.TotalRedBetsPlaced),
+			TotalWhiteEurBet:     payload.TotalWhiteEurBet,
+			TotalWhiteBetsPlaced: int32(payload.TotalWhiteBetsPlaced),
+			TotalBlackEurBet:     payload.TotalBlackEurBet,
+			TotalBlackBetsPlaced: int32(payload.TotalBlackBetsPlaced),
+			Bets:                 bets(payload.Bets),
+		}
+		if payload.Roll != nil {
+			if roll, err := strconv.Atoi(string(*payload.Roll)); err == nil {
+				roll32 := int32(roll)
+				tick.Roll = &roll32
+			}
+		}
+		if payload.Status == blazego.DoubleStatusComplete {
+			if _, color, err := payload.Result(); err == nil {
+				tick.Color = doubleColor(color)
+			}
+		}
+		message.Payload = &blazepb.Event_DoubleTick{DoubleTick: tick}
+
+	case blazego.ChatMessageEvent:
+		message.Payload = &blazepb.Event_ChatMessage{ChatMessage: &blazepb.ChatMessage{
+			Id:        payload.ID,
+			Text:      payload.Text,
+			Available: payload.Available,
+			CreatedAt: payload.CreatedAt,
+			User: &blazepb.ChatUser{
+				Id:       payload.User.ID,
+				Username: payload.User.Username,
+				Rank:     payload.User.Rank,
+				Label:    payload.User.Label,
+				Level:    int32(payload.User.Level),
+			},
+		}}
+
+	case blazego.CloseEvent:
+		message.Payload = &blazepb.Event_Close{Close: &blazepb.CloseEvent{
+			Code:      int32(payload.Code),
+			Reconnect: payload.Reconnect,
+		}}
+	}
+
+	return message, nil
+}
+
+func bets(bets []blazego.Bet) []*blazepb.Bet {
+	messages := make([]*blazepb.Bet, len(bets))
+	for i, bet := range bets {
+		messages[i] = &blazepb.Bet{
+			Id:           bet.ID,
+			CashedOutAt:  bet.CashedOutAt,
+			Amount:       bet.Amount,
+			CurrencyType: bet.CurrencyType,
+			WinAmount:    bet.WinAmount,
+			Status:       bet.Status,
+		}
+	}
+	return messages
+}
+
+func doubleColor(color blazego.DoubleColor) blazepb.DoubleColor {
+	switch color {
+	case blazego.DoubleColorWhite:
+		return blazepb.DoubleColor_DOUBLE_COLOR_WHITE
+	case blazego.DoubleColorRed:
+		return blazepb.DoubleColor_DOUBLE_COLOR_RED
+	case blazego.DoubleColorBlack:
+		return blazepb.DoubleColor_DOUBLE_COLOR_BLACK
+	}
+	return blazepb.DoubleColor_DOUBLE_COLOR_UNSPECIFIED
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,162 @@
+// Package rpc implementa o serviço gRPC BlazeService sobre o gateway, dando acesso tipado aos
+// eventos e ao histórico para serviços escritos em outras linguagens.
+//
+// As definições ficam em blazepb/blaze.proto; o código gerado é atualizado com buf generate.
+package rpc
+
+//go:generate buf generate
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/gateway"
+	"github.com/viniciusgdr/blazego/rpc/blazepb"
+)
+
+const (
+	defaultHistoryLimit = 50
+	messageCacheSize    = 1024
+)
+
+// Server implementa blazepb.BlazeServiceServer com os eventos e históricos do gateway
+type Server struct {
+	blazepb.UnimplementedBlazeServiceServer
+	gateway  *gateway.Gateway
+	messages *messageCache
+}
+
+func NewServer(gw *gateway.Gateway) *Server {
+	return &Server{gateway: gw, messages: newMessageCache(messageCacheSize)}
+}
+
+// messageCache guarda as últimas mensagens convertidas, indexadas pelo ID do evento, para que
+// cada evento seja decodificado uma única vez e a mesma mensagem seja enviada a todos os clientes
+type messageCache struct {
+	mu      sync.Mutex
+	entries []cachedMessage
+}
+
+type cachedMessage struct {
+	id      uint64
+	message *blazepb.Event
+	err     error
+}
+
+func newMessageCache(size int) *messageCache {
+	return &messageCache{entries: make([]cachedMessage, size)}
+}
+
+func (c *messageCache) message(event gateway.Event) (*blazepb.Event, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &c.entries[event.ID%uint64(len(c.entries))]
+	if entry.id != event.ID {
+		message, err := eventMessage(event)
+		*entry = cachedMessage{id: event.ID, message: message, err: err}
+	}
+	return entry.message, entry.err
+}
+
+// Register registra o serviço no servidor gRPC
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	blazepb.RegisterBlazeServiceServer(registrar, s)
+}
+
+func (s *Server) Subscribe(request *blazepb.SubscribeRequest, stream grpc.ServerStreamingServer[blazepb.Event]) error {
+	games := s.gateway.Games()
+	for _, game := range request.GetGames() {
+		if !slices.Contains(games, game) {
+			return status.Errorf(codes.InvalidArgument, "unknown game %q", game)
+		}
+	}
+
+	filter := gateway.Filter{Games: request.GetGames(), Types: request.GetTypes()}
+
+	var subscription *gateway.Subscription
+	if request.LastEventId != nil {
+		subscription = s.gateway.Resume(filter, request.GetLastEventId())
+	} else {
+		subscription = s.gateway.Subscribe(filter)
+	}
+	defer subscription.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case event, ok := <-subscription.Events():
+			if !ok {
+				if subscription.Evicted() {
+					return status.Error(codes.ResourceExhausted, "slow consumer")
+				}
+				return nil
+			}
+
+			message, err := s.messages.message(event)
+			if err != nil {
+				continue
+			}
+			if err := stream.Send(message); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) GetHistory(ctx context.Context, request *blazepb.GetHistoryRequest) (*blazepb.GetHistoryResponse, error) {
+	limit := int(request.GetLimit())
+	if limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid limit")
+	}
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+
+	if !slices.Contains(s.gateway.Games(), request.GetGame()) {
+		return nil, status.Errorf(codes.NotFound, "unknown game %q", request.GetGame())
+	}
+
+	history, ok := s.gateway.History(request.GetGame(), limit)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "game %q has no rounds", request.GetGame())
+	}
+
+	response := &blazepb.GetHistoryResponse{}
+	switch history := history.(type) {
+	case []blazego.CrashResult:
+		for _, result := range history {
+			response.Crash = append(response.Crash, &blazepb.CrashResult{
+				Game:         result.Game,
+				Id:           result.ID,
+				CrashPoint:   result.CrashPoint,
+				IsBonusRound: result.IsBonusRound,
+				UpdatedAt:    result.UpdatedAt,
+				CompletedAt:  timestamp(result.CompletedAt),
+			})
+		}
+
+	case []blazego.DoubleRound:
+		for _, round := range history {
+			response.Double = append(response.Double, &blazepb.DoubleResult{
+				Id:          round.ID,
+				Color:       doubleColor(round.Color),
+				Roll:        int32(round.Roll),
+				CreatedAt:   round.CreatedAt,
+				UpdatedAt:   round.UpdatedAt,
+				StartedAt:   timestamp(round.StartedAt),
+				CompletedAt: timestamp(round.CompletedAt),
+			})
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,311 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/gateway"
+	"github.com/viniciusgdr/blazego/rpc/blazepb"
+)
+
+const testTimeout = 5 * time.Second
+
+// fakeConnection entrega ao gateway os eventos emitidos pelo teste
+type fakeConnection struct {
+	mu        sync.Mutex
+	callbacks map[string][]func(data interface{})
+}
+
+func (c *fakeConnection) Connect(options blazego.SocketOptions) error { return nil }
+func (c *fakeConnection) Send(data interface{}) error                 { return nil }
+func (c *fakeConnection) Disconnect() error                           { return nil }
+
+func (c *fakeConnection) On(event string, callback func(data interface{})) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.callbacks == nil {
+		c.callbacks = make(map[string][]func(data interface{}))
+	}
+	c.callbacks[event] = append(c.callbacks[event], callback)
+}
+
+func (c *fakeConnection) Emit(event string, data interface{}) {
+	c.mu.Lock()
+	callbacks := slices.Clone(c.callbacks[event])
+	c.mu.Unlock()
+
+	for _, callback := range callbacks {
+		callback(data)
+	}
+}
+
+// newTestClient serve o gateway por bufconn e anexa uma fakeConnection a cada jogo
+func newTestClient(t *testing.T, options gateway.Options) (blazepb.BlazeServiceClient, map[string]*fakeConnection) {
+	t.Helper()
+
+	g := gateway.New(options)
+	conns := map[string]*fakeConnection{}
+	for _, game := range g.Games() {
+		conns[game] = &fakeConnection{}
+		if err := g.Attach(game, conns[game]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	NewServer(g).Register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	client, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// janelas fixas: sem leitura do cliente, o servidor para de enviar e a assinatura enche
+		grpc.WithInitialWindowSize(1<<16),
+		grpc.WithInitialConnWindowSize(1<<16),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	return blazepb.NewBlazeServiceClient(client), conns
+}
+
+func crashTick(id, tickStatus string, point float64) blazego.CrashTickEvent {
+	event := blazego.CrashTickEvent{ID: id, Status: tickStatus}
+	if point > 0 {
+		crashPoint := blazego.Float64String(point)
+		event.CrashPoint = &crashPoint
+	}
+	return event
+}
+
+// receive lê n eventos do stream e devolve "jogo:tipo:id" de cada um
+func receive(t *testing.T, stream grpc.ServerStreamingClient[blazepb.Event], n int) []string {
+	t.Helper()
+
+	events := []string{}
+	for range n {
+		event, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("events = %v: %v", events, err)
+		}
+		events = append(events, fmt.Sprintf("%s:%s:%d", event.GetGame(), event.GetType(), event.GetId()))
+	}
+	return events
+}
+
+func TestMessageCache(t *testing.T) {
+	cache := newMessageCache(2)
+	event := func(id uint64) gateway.Event {
+		return gateway.Event{ID: id, Game: "crash", Type: "crash.tick", Payload: json.RawMessage(`{"id":"c1","status":"waiting"}`)}
+	}
+
+	first, err := cache.message(event(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GetCrashTick().GetStatus() != "waiting" {
+		t.Fatalf("message = %v", first)
+	}
+
+	// os demais clientes recebem a mesma mensagem, sem uma nova conversão
+	if again, _ := cache.message(event(1)); again != first {
+		t.Error("event 1 converted twice")
+	}
+
+	// o evento 3 ocupa o lugar do 1, que volta a ser convertido se pedido depois
+	cache.message(event(3))
+	if again, _ := cache.message(event(1)); again == first || again.GetId() != 1 {
+		t.Errorf("evicted message = %v", again)
+	}
+
+	if _, err := cache.message(gateway.Event{ID: 4, Type: "unknown"}); err == nil {
+		t.Error("unknown event type converted")
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	client, conns := newTestClient(t, gateway.Options{Games: []string{"crash", "doubles"}})
+
+	conns["crash"].Emit("crash.tick", crashTick("c1", "waiting", 0))
+	conns["doubles"].Emit("double.tick", blazego.DoubleTickEvent{ID: "d1", Status: blazego.DoubleStatusWaiting})
+	conns["crash"].Emit("crash.tick-bets", blazego.CrashTickBetsEvent{ID: "c1"})
+	conns["crash"].Emit("crash.tick", crashTick("c1", "graphing", 0))
+
+	lastEventID := func(id uint64) *uint64 { return &id }
+	tests := []struct {
+		name    string
+		request *blazepb.SubscribeRequest
+		want    []string
+	}{
+		{"all", &blazepb.SubscribeRequest{LastEventId: lastEventID(0)},
+			[]string{"crash:crash.tick:1", "doubles:double.tick:2", "crash:crash.tick-bets:3", "crash:crash.tick:4"}},
+		{"games filter", &blazepb.SubscribeRequest{Games: []string{"doubles"}, LastEventId: lastEventID(0)},
+			[]string{"doubles:double.tick:2"}},
+		{"types filter", &blazepb.SubscribeRequest{Types: []string{"crash.tick"}, LastEventId: lastEventID(0)},
+			[]string{"crash:crash.tick:1", "crash:crash.tick:4"}},
+		{"resume", &blazepb.SubscribeRequest{LastEventId: lastEventID(2)},
+			[]string{"crash:crash.tick-bets:3", "crash:crash.tick:4"}},
+		{"resume with games filter", &blazepb.SubscribeRequest{Games: []string{"crash"}, LastEventId: lastEventID(1)},
+			[]string{"crash:crash.tick-bets:3", "crash:crash.tick:4"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+			defer cancel()
+
+			stream, err := client.Subscribe(ctx, test.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := receive(t, stream, len(test.want)); !slices.Equal(got, test.want) {
+				t.Errorf("events = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSubscribeLive(t *testing.T) {
+	client, conns := newTestClient(t, gateway.Options{Games: []string{"crash"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &blazepb.SubscribeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a assinatura é registrada depois da chamada, então os ticks são repetidos até o primeiro chegar
+	received := make(chan *blazepb.Event, 1)
+	go func() {
+		event, err := stream.Recv()
+		if err == nil {
+			received <- event
+		}
+	}()
+
+	for {
+		conns["crash"].Emit("crash.tick", crashTick("c1", "waiting", 0))
+		select {
+		case event := <-received:
+			if event.GetCrashTick().GetId() != "c1" {
+				t.Errorf("event = %v", event)
+			}
+			return
+		case <-ctx.Done():
+			t.Fatal("no live event received")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestSubscribeUnknownGame(t *testing.T) {
+	client, _ := newTestClient(t, gateway.Options{Games: []string{"crash"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	stream, err := client.Subscribe(ctx, &blazepb.SubscribeRequest{Games: []string{"crash", "roleta"}})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestSubscribeSlowConsumer(t *testing.T) {
+	client, conns := newTestClient(t, gateway.Options{Games: []string{"crash"}, ClientBuffer: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	conns["crash"].Emit("crash.tick", crashTick("c0", "waiting", 0))
+	stream, err := client.Subscribe(ctx, &blazepb.SubscribeRequest{LastEventId: new(uint64)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// o primeiro evento confirma que a assinatura foi registrada
+	receive(t, stream, 1)
+
+	// sem leitura, as janelas do HTTP/2 enchem e o buffer do cliente transborda
+	for i := range 20000 {
+		conns["crash"].Emit("crash.tick", crashTick(fmt.Sprintf("c%d", i+1), "waiting", 0))
+	}
+
+	for {
+		if _, err = stream.Recv(); err != nil {
+			break
+		}
+	}
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("err = %v, want ResourceExhausted", err)
+	}
+}
+
+func TestGetHistory(t *testing.T) {
+	client, conns := newTestClient(t, gateway.Options{Games: []string{"crash", "doubles", "chat"}})
+
+	for i := range 60 {
+		conns["crash"].Emit("crash.tick", crashTick(fmt.Sprintf("c%d", i), "complete", 2))
+	}
+
+	tests := []struct {
+		name    string
+		request *blazepb.GetHistoryRequest
+		want    int
+		code    codes.Code
+	}{
+		{"default limit", &blazepb.GetHistoryRequest{Game: "crash"}, 50, codes.OK},
+		{"limit", &blazepb.GetHistoryRequest{Game: "crash", Limit: 3}, 3, codes.OK},
+		{"limit above history", &blazepb.GetHistoryRequest{Game: "crash", Limit: 100}, 60, codes.OK},
+		{"no rounds yet", &blazepb.GetHistoryRequest{Game: "doubles"}, 0, codes.OK},
+		{"negative limit", &blazepb.GetHistoryRequest{Game: "crash", Limit: -1}, 0, codes.InvalidArgument},
+		{"unknown game", &blazepb.GetHistoryRequest{Game: "roleta"}, 0, codes.NotFound},
+		{"game without rounds", &blazepb.GetHistoryRequest{Game: "chat"}, 0, codes.FailedPrecondition},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+			defer cancel()
+
+			response, err := client.GetHistory(ctx, test.request)
+			if status.Code(err) != test.code {
+				t.Fatalf("err = %v, want %v", err, test.code)
+			}
+			if got := len(response.GetCrash()) + len(response.GetDouble()); got != test.want {
+				t.Errorf("results = %d, want %d", got, test.want)
+			}
+		})
+	}
+
+	response, err := client.GetHistory(context.Background(), &blazepb.GetHistoryRequest{Game: "crash", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := []string{response.Crash[0].GetId(), response.Crash[1].GetId()}; !slices.Equal(ids, []string{"c58", "c59"}) {
+		t.Errorf("ids = %v, want the last two rounds", ids)
+	}
+}
@@ -0,0 +1,238 @@
+package blazego
+
+import (
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SessionFrame representa um frame recebido pelo socket e gravado pelo SessionRecorder
+type SessionFrame struct {
+	Time time.Time `json:"time"`
+	// Monotonic é o tempo em nanossegundos desde o início da gravação, medido pelo relógio monotônico
+	Monotonic    int64  `json:"mono"`
+	ConnectionID string `json:"conn"`
+	Room         string `json:"room,omitempty"`
+	Event        string `json:"event,omitempty"`
+	Data         string `json:"data"`
+}
+
+// RecorderOptions configura o arquivo e a rotação do SessionRecorder
+type RecorderOptions struct {
+	// Path é o arquivo JSON Lines atual; os arquivos rotacionados recebem o horário da rotação no nome
+	Path string
+	// MaxBytes rotaciona o arquivo quando o tamanho ultrapassar o limite (0 desabilita)
+	MaxBytes int64
+	// MaxAge rotaciona o arquivo depois do intervalo informado (0 desabilita)
+	MaxAge time.Duration
+	// DisableCompression mantém os arquivos rotacionados sem gzip
+	DisableCompression bool
+}
+
+// SessionRecorder grava os frames recebidos em JSON Lines com rotação por tamanho ou tempo
+type SessionRecorder struct {
+	mu       sync.Mutex
+	options  RecorderOptions
+	start    time.Time
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	rotating sync.WaitGroup
+
+	// compressErr guarda o erro da compressão em segundo plano até o próximo Record ou Close
+	compressMu  sync.Mutex
+	compressErr error
+}
+
+func NewSessionRecorder(options RecorderOptions) (*SessionRecorder, error) {
+	if options.Path == "" {
+		return nil, errors.New("missing path")
+	}
+
+	recorder := &SessionRecorder{
+		options: options,
+		start:   time.Now(),
+	}
+
+	if err := recorder.open(); err != nil {
+		return nil, err
+	}
+
+	return recorder, nil
+}
+
+// NewConnectionID gera um identificador aleatório para diferenciar as conexões gravadas
+func NewConnectionID() string {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(id)
+}
+
+// Record grava o frame preenchendo Time e Monotonic. Também retorna o erro da compressão de um
+// arquivo rotacionado, que acontece em segundo plano; nesse caso o frame já foi gravado.
+func (r *SessionRecorder) Record(frame SessionFrame) error {
+	now := time.Now()
+	frame.Time = now
+	frame.Monotonic = int64(now.Sub(r.start))
+
+	line, err := json.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return errors.New("recorder closed")
+	}
+
+	var rotateErr error
+	if r.shouldRotate(now, int64(len(line))) {
+		// rotate mantém o arquivo atual aberto quando possível, então o frame ainda é gravado
+		if rotateErr = r.rotate(now); r.file == nil {
+			return rotateErr
+		}
+	}
+
+	n, err := r.file.Write(line)
+	r.size += int64(n)
+
+	return errors.Join(rotateErr, err, r.takeCompressError())
+}
+
+// Close fecha o arquivo atual e aguarda a compressão dos arquivos rotacionados
+func (r *SessionRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return errors.New("recorder closed")
+	}
+
+	err := r.file.Close()
+	r.file = nil
+	r.rotating.Wait()
+	return errors.Join(err, r.takeCompressError())
+}
+
+func (r *SessionRecorder) takeCompressError() error {
+	r.compressMu.Lock()
+	defer r.compressMu.Unlock()
+
+	err := r.compressErr
+	r.compressErr = nil
+	return err
+}
+
+func (r *SessionRecorder) open() error {
+	if dir := filepath.Dir(r.options.Path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(r.options.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	r.file = file
+	r.size = info.Size()
+	r.openedAt = time.Now()
+	return nil
+}
+
+func (r *SessionRecorder) shouldRotate(now time.Time, next int64) bool {
+	if r.size == 0 {
+		return false
+	}
+	if r.options.MaxBytes > 0 && r.size+next > r.options.MaxBytes {
+		return true
+	}
+	if r.options.MaxAge > 0 && now.Sub(r.openedAt) >= r.options.MaxAge {
+		return true
+	}
+	return false
+}
+
+// rotate renomeia o arquivo atual e abre um novo; se a renomeação falhar, o arquivo original é
+// reaberto e a gravação continua nele
+func (r *SessionRecorder) rotate(now time.Time) error {
+	err := r.file.Close()
+	r.file = nil
+	if err != nil {
+		return errors.Join(err, r.open())
+	}
+
+	ext := filepath.Ext(r.options.Path)
+	base := strings.TrimSuffix(r.options.Path, ext)
+	rotated := base + "-" + now.Format("20060102T150405.000000000") + ext
+
+	if err := os.Rename(r.options.Path, rotated); err != nil {
+		return errors.Join(err, r.open())
+	}
+
+	if !r.options.DisableCompression {
+		r.rotating.Add(1)
+		go func() {
+			defer r.rotating.Done()
+			if err := compressFile(rotated); err != nil {
+				r.compressMu.Lock()
+				r.compressErr = errors.Join(r.compressErr, err)
+				r.compressMu.Unlock()
+			}
+		}()
+	}
+
+	return r.open()
+}
+
+func compressFile(path string) error {
+	source, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	target, err := os.Create(path + ".gz")
+	if err != nil {
+		return err
+	}
+
+	writer := gzip.NewWriter(target)
+	if _, err := io.Copy(writer, source); err != nil {
+		writer.Close()
+		target.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		target.Close()
+		return err
+	}
+	if err := target.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
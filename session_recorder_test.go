@@ -0,0 +1,126 @@
+package blazego
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDataFrameEvent(t *testing.T) {
+	tests := []struct {
+		frame string
+		event string
+		ok    bool
+	}{
+		{`42["data",{"id":"crash.tick","payload":{"id":"x","status":"waiting"}}]`, "crash.tick", true},
+		{`42["data",{"payload":{"id":"x"},"id":"double.tick"}]`, "double.tick", true},
+		{`42["data", {"id":"chat.message","payload":{}}]`, "chat.message", true},
+		{`42["data",{"payload":{"id":"x"}}]`, "", false},
+		{`42["data",{"id":"","payload":{}}]`, "", false},
+		{`42["data",{broken}]`, "", false},
+		{`40`, "", false},
+		{`3`, "", false},
+	}
+
+	for _, test := range tests {
+		event, ok := dataFrameEvent(test.frame)
+		if event != test.event || ok != test.ok {
+			t.Errorf("dataFrameEvent(%s) = %q, %v, want %q, %v", test.frame, event, ok, test.event, test.ok)
+		}
+	}
+}
+
+func TestSessionRecorderRotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		compress bool
+		suffix   string
+	}{
+		{"compressed", true, ".jsonl.gz"},
+		{"plain", false, ".jsonl"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "session.jsonl")
+
+			recorder, err := NewSessionRecorder(RecorderOptions{
+				Path:               path,
+				MaxBytes:           300,
+				DisableCompression: !test.compress,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for range 10 {
+				frame := SessionFrame{ConnectionID: "c", Data: `42["data",{"id":"crash.tick","payload":{"id":"a","status":"waiting"}}]`}
+				if err := recorder.Record(frame); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := recorder.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rotated := 0
+			for _, entry := range entries {
+				if entry.Name() != "session.jsonl" && strings.HasSuffix(entry.Name(), test.suffix) {
+					rotated++
+				}
+			}
+			if rotated == 0 {
+				t.Fatalf("no rotated files in %v", entries)
+			}
+
+			frames, err := LoadSessionFrames(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(frames) == 0 || frames[0].ConnectionID != "c" {
+				t.Errorf("frames = %+v", frames)
+			}
+		})
+	}
+}
+
+func TestSessionRecorderRenameFailure(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "sessions")
+	path := filepath.Join(dir, "session.jsonl")
+
+	recorder, err := NewSessionRecorder(RecorderOptions{Path: path, MaxBytes: 1, DisableCompression: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.Close()
+
+	if err := recorder.Record(SessionFrame{Data: "first"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// sem o arquivo atual a renomeação falha e o recorder reabre o caminho original
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Record(SessionFrame{Data: "second"}); err == nil {
+		t.Fatal("expected rotation error")
+	}
+	if err := recorder.Record(SessionFrame{Data: "third"}); err != nil {
+		t.Fatalf("recorder did not recover: %v", err)
+	}
+
+	frames, err := LoadSessionFrames(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 1 || frames[0].Data != "third" {
+		t.Errorf("frames = %+v, want third", frames)
+	}
+}
@@ -0,0 +1,181 @@
+package blazego
+
+// CrashResultsFromFrames extrai os resultados do crash de uma sessão gravada, em ordem cronológica.
+// Com game vazio, todas as salas do crash são consideradas.
+func CrashResultsFromFrames(frames []SessionFrame, game string) []CrashResult {
+	results := []CrashResult{}
+	seen := make(map[string]struct{})
+
+	for _, frame := range frames {
+		if frame.Event != "" && frame.Event != "crash.tick" {
+			continue
+		}
+
+		frameGame, _ := GameForRoom(frame.Room)
+		if game != "" && frameGame != "" && frameGame != game {
+			continue
+		}
+
+		eventID, payload, ok := parseDataFrame(frame.Data)
+		if !ok || eventID != "crash.tick" {
+			continue
+		}
+
+		tickEvent, err := DecodeEvent[CrashTickEvent](payload)
+		if err != nil || tickEvent.Status != "complete" || tickEvent.CrashPoint == nil {
+			continue
+		}
+
+		if _, exists := seen[tickEvent.ID]; exists {
+			continue
+		}
+		seen[tickEvent.ID] = struct{}{}
+
+		results = append(results, CrashResult{
+			Game:         frameGame,
+			ID:           tickEvent.ID,
+			CrashPoint:   float64(*tickEvent.CrashPoint),
+			IsBonusRound: tickEvent.IsBonusRound,
+			UpdatedAt:    tickEvent.UpdatedAt,
+			CompletedAt:  frame.Time,
+		})
+	}
+
+	return results
+}
+
+// DoubleRoundsFromFrames extrai as rodadas finalizadas do double de uma sessão gravada, em ordem cronológica
+func DoubleRoundsFromFrames(frames []SessionFrame) []DoubleRound {
+	rounds := []DoubleRound{}
+	seen := make(map[string]struct{})
+
+	for _, frame := range frames {
+		if frame.Event != "" && frame.Event != "double.tick" {
+			continue
+		}
+
+		eventID, payload, ok := parseDataFrame(frame.Data)
+		if !ok || eventID != "double.tick" {
+			continue
+		}
+
+		tickEvent, err := DecodeEvent[DoubleTickEvent](payload)
+		if err != nil || tickEvent.Status != DoubleStatusComplete {
+			continue
+		}
+
+		if _, exists := seen[tickEvent.ID]; exists {
+			continue
+		}
+
+		roll, color, err := doubleResult(tickEvent)
+		if err != nil {
+			continue
+		}
+		seen[tickEvent.ID] = struct{}{}
+
+		rounds = append(rounds, DoubleRound{
+			ID:          tickEvent.ID,
+			Status:      tickEvent.Status,
+			Color:       color,
+			Roll:        roll,
+			CreatedAt:   tickEvent.CreatedAt,
+			UpdatedAt:   tickEvent.UpdatedAt,
+			CompletedAt: frame.Time,
+		})
+	}
+
+	return rounds
+}
+
+// CrashBetsFromFrames calcula o resumo das apostas de cada rodada do crash de uma sessão gravada.
+// Com game vazio, todas as salas do crash são consideradas.
+func CrashBetsFromFrames(frames []SessionFrame, game string, topN int) []CrashBetsSummary {
+	summaries := []CrashBetsSummary{}
+	aggregators := make(map[string]*CrashBetsAggregator)
+	// lastSummary é a posição em summaries do último resumo de cada jogo
+	lastSummary := make(map[string]int)
+
+	for _, frame := range frames {
+		if frame.Event != "" && frame.Event != "crash.tick" && frame.Event != "crash.tick-bets" {
+			continue
+		}
+
+		frameGame, _ := GameForRoom(frame.Room)
+		if game != "" && frameGame != "" && frameGame != game {
+			continue
+		}
+
+		eventID, payload, ok := parseDataFrame(frame.Data)
+		if !ok {
+			continue
+		}
+
+		aggregator, exists := aggregators[frameGame]
+		if !exists {
+			aggregator = NewCrashBetsAggregator(frameGame, topN, 1)
+			aggregators[frameGame] = aggregator
+		}
+
+		switch eventID {
+		case "crash.tick-bets":
+			betsEvent, err := DecodeEvent[CrashTickBetsEvent](payload)
+			if err != nil {
+				continue
+			}
+			// apostas que chegam depois do complete atualizam o resumo da rodada
+			if summary, ok := aggregator.HandleBets(betsEvent); ok {
+				if last, exists := lastSummary[frameGame]; exists && summaries[last].ID == summary.ID {
+					summary.CompletedAt = summaries[last].CompletedAt
+					summaries[last] = summary
+				} else {
+					summary.CompletedAt = frame.Time
+					lastSummary[frameGame] = len(summaries)
+					summaries = append(summaries, summary)
+				}
+			}
+
+		case "crash.tick":
+			tickEvent, err := DecodeEvent[CrashTickEvent](payload)
+			if err != nil {
+				continue
+			}
+			if summary, ok := aggregator.HandleTick(tickEvent); ok {
+				summary.CompletedAt = frame.Time
+				lastSummary[frameGame] = len(summaries)
+				summaries = append(summaries, summary)
+			}
+		}
+	}
+
+	return summaries
+}
+
+// DoubleBetsFromFrames calcula o sentimento das apostas de cada rodada finalizada do double de uma sessão gravada
+func DoubleBetsFromFrames(frames []SessionFrame) []DoubleBetsSummary {
+	summaries := []DoubleBetsSummary{}
+	aggregator := NewDoubleBetsAggregator(1)
+
+	for _, frame := range frames {
+		if frame.Event != "" && frame.Event != "double.tick" {
+			continue
+		}
+
+		eventID, payload, ok := parseDataFrame(frame.Data)
+		if !ok || eventID != "double.tick" {
+			continue
+		}
+
+		tickEvent, err := DecodeEvent[DoubleTickEvent](payload)
+		if err != nil {
+			continue
+		}
+
+		if summary, ok := aggregator.Handle(tickEvent); ok {
+			summary.CompletedAt = frame.Time
+			summaries = append(summaries, summary)
+		}
+	}
+
+	return summaries
+}
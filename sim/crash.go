@@ -0,0 +1,246 @@
+package sim
+
+import (
+	"encoding/hex"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/fair"
+)
+
+// CrashOptions configura o CrashSimulator; campos zerados usam os valores padrão
+type CrashOptions struct {
+	Game string // "crash", "crash_2" ou "crash_neymarjr" (padrão "crash")
+	// HouseEdge é a margem da casa em porcentagem, aplicada como no algoritmo publicado: uma rodada
+	// em floor(100/HouseEdge) termina instantaneamente em 1.00x (padrão fair.DefaultCrashHouseEdge;
+	// negativo desabilita)
+	HouseEdge float64
+	// BonusRate é a probabilidade de uma rodada bônus (apenas crash_2)
+	BonusRate float64
+	// BonusMultiplier multiplica o ponto das rodadas bônus, e com ele o pagamento das apostas (padrão 2)
+	BonusMultiplier  float64
+	WaitingDuration  time.Duration // duração do status "waiting" (padrão 15s)
+	CompleteDuration time.Duration // pausa entre o "complete" e a próxima rodada (padrão 3s)
+	// GrowthRate é a taxa de crescimento do multiplicador por segundo, m(t) = e^(GrowthRate*t) (padrão 0.06)
+	GrowthRate float64
+	// Players é a média de jogadores por rodada; com 0 nenhum crash.tick-bets é gerado
+	Players      int
+	MeanBet      float64       // valor médio das apostas (padrão 10)
+	BetsInterval time.Duration // intervalo dos crash.tick-bets durante a rodada (padrão 1s)
+	Seed         int64
+	Start        time.Time // horário da primeira rodada, usado em updated_at
+}
+
+// CrashSimulator gera rodadas do crash com a distribuição padrão do multiplicador
+type CrashSimulator struct {
+	options CrashOptions
+	random  *rand.Rand
+	elapsed time.Duration
+}
+
+type simulatedBet struct {
+	bet    blazego.Bet
+	target float64
+}
+
+func NewCrashSimulator(options CrashOptions) *CrashSimulator {
+	if options.Game == "" {
+		options.Game = "crash"
+	}
+	if options.HouseEdge == 0 {
+		options.HouseEdge = fair.DefaultCrashHouseEdge
+	}
+	if options.BonusMultiplier == 0 {
+		options.BonusMultiplier = 2
+	}
+	if options.WaitingDuration == 0 {
+		options.WaitingDuration = 15 * time.Second
+	}
+	if options.CompleteDuration == 0 {
+		options.CompleteDuration = 3 * time.Second
+	}
+	if options.GrowthRate == 0 {
+		options.GrowthRate = 0.06
+	}
+	if options.MeanBet == 0 {
+		options.MeanBet = 10
+	}
+	if options.BetsInterval == 0 {
+		options.BetsInterval = time.Second
+	}
+	if options.Start.IsZero() {
+		options.Start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return &CrashSimulator{
+		options: options,
+		random:  rand.New(rand.NewSource(options.Seed)),
+	}
+}
+
+// CrashPoint sorteia um multiplicador aplicando o algoritmo publicado (fair.CrashPointFromHash)
+// a um hash aleatório
+func (s *CrashSimulator) CrashPoint() float64 {
+	hash := make([]byte, 32)
+	s.random.Read(hash)
+	return fair.CrashPointFromHash(hex.EncodeToString(hash), max(s.options.HouseEdge, 0))
+}
+
+// Rounds gera n rodadas seguidas
+func (s *CrashSimulator) Rounds(n int) []Tick {
+	ticks := []Tick{}
+	for range n {
+		ticks = append(ticks, s.NextRound()...)
+	}
+	return ticks
+}
+
+// NextRound gera os ticks de uma rodada: "waiting", "graphing" e "complete", com os
+// crash.tick-bets das apostas e saídas dos jogadores quando Players for maior que zero
+func (s *CrashSimulator) NextRound() []Tick {
+	id := randomID(s.random)
+	point := s.CrashPoint()
+	bonus := s.options.Game == "crash_2" && s.random.Float64() < s.options.BonusRate
+	if bonus {
+		point = roundCents(point * s.options.BonusMultiplier)
+	}
+
+	start := s.elapsed
+	graphing := start + s.options.WaitingDuration
+	duration := time.Duration(math.Log(point) / s.options.GrowthRate * float64(time.Second))
+	complete := graphing + duration
+
+	ticks := []Tick{s.crashTick(start, id, "waiting", nil, bonus)}
+
+	bets := s.placeBets()
+	if len(bets) > 0 {
+		ticks = append(ticks, s.betsTick(graphing-time.Millisecond, id, bets))
+	}
+
+	ticks = append(ticks, s.crashTick(graphing, id, "graphing", nil, bonus))
+
+	if len(bets) > 0 {
+		for at := graphing + s.options.BetsInterval; at < complete; at += s.options.BetsInterval {
+			multiplier := math.Exp(s.options.GrowthRate * (at - graphing).Seconds())
+			if s.cashOut(bets, multiplier) {
+				ticks = append(ticks, s.betsTick(at, id, bets))
+			}
+		}
+		s.cashOut(bets, point)
+	}
+
+	ticks = append(ticks, s.crashTick(complete, id, "complete", &point, bonus))
+	if len(bets) > 0 {
+		ticks = append(ticks, s.betsTick(complete, id, bets))
+	}
+
+	s.elapsed = complete + s.options.CompleteDuration
+	return ticks
+}
+
+func (s *CrashSimulator) crashTick(at time.Duration, id, status string, point *float64, bonus bool) Tick {
+	event := blazego.CrashTickEvent{
+		ID:           id,
+		UpdatedAt:    s.options.Start.Add(at).Format(time.RFC3339Nano),
+		Status:       status,
+		IsBonusRound: bonus,
+	}
+
+	if point != nil {
+		crashPoint := blazego.Float64String(*point)
+		event.CrashPoint = &crashPoint
+	}
+
+	return Tick{At: at, Event: "crash.tick", Payload: event}
+}
+
+func (s *CrashSimulator) placeBets() []*simulatedBet {
+	if s.options.Players <= 0 {
+		return nil
+	}
+
+	players := int(float64(s.options.Players) * (0.5 + s.random.Float64()))
+	bets := make([]*simulatedBet, players)
+	for i := range bets {
+		bets[i] = &simulatedBet{
+			bet: blazego.Bet{
+				ID:           randomID(s.random),
+				Amount:       randomAmount(s.random, s.options.MeanBet),
+				CurrencyType: "BRL",
+				WinAmount:    "0",
+				Status:       "created",
+			},
+			target: roundCents(1.01 + s.random.ExpFloat64()*1.5),
+		}
+	}
+	return bets
+}
+
+// cashOut marca como ganhas as apostas cujo alvo foi atingido abaixo do multiplicador atual
+func (s *CrashSimulator) cashOut(bets []*simulatedBet, multiplier float64) bool {
+	changed := false
+	for _, bet := range bets {
+		if bet.bet.Status != "created" || bet.target >= multiplier {
+			continue
+		}
+
+		target := bet.target
+		bet.bet.Status = "win"
+		bet.bet.CashedOutAt = &target
+		bet.bet.WinAmount = strconv.FormatFloat(roundCents(bet.bet.Amount*target), 'f', 2, 64)
+		changed = true
+	}
+	return changed
+}
+
+func (s *CrashSimulator) betsTick(at time.Duration, id string, bets []*simulatedBet) Tick {
+	event := blazego.CrashTickBetsEvent{
+		ID:              id,
+		RoomID:          s.roomID(),
+		TotalBetsPlaced: strconv.Itoa(len(bets)),
+		Bets:            make([]blazego.Bet, len(bets)),
+	}
+
+	for i, bet := range bets {
+		event.Bets[i] = bet.bet
+		event.TotalEurBet += bet.bet.Amount
+		if bet.bet.CashedOutAt != nil {
+			event.TotalEurWon += bet.bet.Amount * *bet.bet.CashedOutAt
+		}
+	}
+	event.TotalEurBet = roundCents(event.TotalEurBet)
+	event.TotalEurWon = roundCents(event.TotalEurWon)
+
+	return Tick{At: at, Event: "crash.tick-bets", Payload: event}
+}
+
+func (s *CrashSimulator) roomID() int {
+	room, _ := blazego.RoomForGame(s.options.Game)
+	id, _ := strconv.Atoi(room[strings.LastIndex(room, "_")+1:])
+	return id
+}
+
+// CrashResults extrai os resultados das rodadas finalizadas dos ticks gerados
+func CrashResults(game string, start time.Time, ticks []Tick) []blazego.CrashResult {
+	results := []blazego.CrashResult{}
+	for _, tick := range ticks {
+		event, ok := tick.Payload.(blazego.CrashTickEvent)
+		if !ok || event.Status != "complete" || event.CrashPoint == nil {
+			continue
+		}
+
+		results = append(results, blazego.CrashResult{
+			Game:         game,
+			ID:           event.ID,
+			CrashPoint:   float64(*event.CrashPoint),
+			IsBonusRound: event.IsBonusRound,
+			UpdatedAt:    event.UpdatedAt,
+			CompletedAt:  start.Add(tick.At),
+		})
+	}
+	return results
+}
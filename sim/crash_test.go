@@ -0,0 +1,73 @@
+package sim
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCrashSimulatorDistribution(t *testing.T) {
+	const rounds = 200000
+
+	tests := []struct {
+		name      string
+		houseEdge float64
+		// atLeast2 é P(ponto >= 2) do algoritmo publicado: (1 - 1/floor(100/edge)) * 99/199
+		atLeast2 float64
+	}{
+		{"default edge", 0, 32.0 / 33 * 99 / 199},
+		{"no edge", -1, 99.0 / 199},
+		{"edge 5", 5, 19.0 / 20 * 99 / 199},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			simulator := NewCrashSimulator(CrashOptions{HouseEdge: test.houseEdge, Seed: 1})
+
+			hits := 0
+			for range rounds {
+				if simulator.CrashPoint() >= 2 {
+					hits++
+				}
+			}
+
+			if got := float64(hits) / rounds; math.Abs(got-test.atLeast2) > 0.005 {
+				t.Errorf("P(point >= 2) = %.4f, want %.4f", got, test.atLeast2)
+			}
+		})
+	}
+}
+
+func TestCrashSimulatorBonus(t *testing.T) {
+	tests := []struct {
+		name       string
+		game       string
+		multiplier float64
+		min        float64
+	}{
+		{"default multiplier", "crash_2", 0, 2},
+		{"custom multiplier", "crash_2", 5, 5},
+		{"no bonus outside crash_2", "crash", 5, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			simulator := NewCrashSimulator(CrashOptions{Game: test.game, BonusRate: 1, BonusMultiplier: test.multiplier, Seed: 1})
+
+			results := CrashResults(test.game, simulator.options.Start, simulator.Rounds(200))
+			if len(results) != 200 {
+				t.Fatalf("got %d results", len(results))
+			}
+
+			lowest := math.Inf(1)
+			for _, result := range results {
+				if result.IsBonusRound != (test.game == "crash_2") {
+					t.Fatalf("result %s bonus = %v", result.ID, result.IsBonusRound)
+				}
+				lowest = math.Min(lowest, result.CrashPoint)
+			}
+			if lowest != test.min {
+				t.Errorf("lowest crash point = %v, want %v", lowest, test.min)
+			}
+		})
+	}
+}
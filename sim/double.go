@@ -0,0 +1,210 @@
+package sim
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+// DoubleOptions configura o DoubleSimulator; campos zerados usam os valores padrão
+type DoubleOptions struct {
+	WaitingDuration  time.Duration // duração do status "waiting" (padrão 15s)
+	RollingDuration  time.Duration // duração do status "rolling" (padrão 5s)
+	CompleteDuration time.Duration // pausa entre o "complete" e a próxima rodada (padrão 3s)
+	// BetsInterval é o intervalo entre os ticks "waiting" com os totais parciais das apostas (padrão 3s)
+	BetsInterval time.Duration
+	Players      int     // média de jogadores por rodada (0 gera rodadas sem apostas)
+	MeanBet      float64 // valor médio das apostas (padrão 10)
+	Seed         int64
+	Start        time.Time // horário da primeira rodada, usado em created_at/updated_at
+}
+
+// DoubleSimulator gera rodadas do double com números sorteados uniformemente entre 0 e 14
+type DoubleSimulator struct {
+	options DoubleOptions
+	random  *rand.Rand
+	elapsed time.Duration
+}
+
+type simulatedDoubleBet struct {
+	bet   blazego.Bet
+	color blazego.DoubleColor
+}
+
+func NewDoubleSimulator(options DoubleOptions) *DoubleSimulator {
+	if options.WaitingDuration == 0 {
+		options.WaitingDuration = 15 * time.Second
+	}
+	if options.RollingDuration == 0 {
+		options.RollingDuration = 5 * time.Second
+	}
+	if options.CompleteDuration == 0 {
+		options.CompleteDuration = 3 * time.Second
+	}
+	if options.BetsInterval == 0 {
+		options.BetsInterval = 3 * time.Second
+	}
+	if options.MeanBet == 0 {
+		options.MeanBet = 10
+	}
+	if options.Start.IsZero() {
+		options.Start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return &DoubleSimulator{
+		options: options,
+		random:  rand.New(rand.NewSource(options.Seed)),
+	}
+}
+
+// Rounds gera n rodadas seguidas
+func (s *DoubleSimulator) Rounds(n int) []Tick {
+	ticks := []Tick{}
+	for range n {
+		ticks = append(ticks, s.NextRound()...)
+	}
+	return ticks
+}
+
+// NextRound gera os ticks de uma rodada: "waiting" (com os totais parciais), "rolling" e "complete"
+func (s *DoubleSimulator) NextRound() []Tick {
+	id := randomID(s.random)
+	roll := s.random.Intn(15)
+	color, _ := blazego.DoubleColorFromRoll(roll)
+
+	start := s.elapsed
+	rolling := start + s.options.WaitingDuration
+	complete := rolling + s.options.RollingDuration
+
+	bets := s.placeBets()
+	ticks := []Tick{}
+
+	steps := int(s.options.WaitingDuration / s.options.BetsInterval)
+	if steps < 1 {
+		steps = 1
+	}
+	for step := range steps {
+		at := start + time.Duration(step)*s.options.BetsInterval
+		placed := len(bets) * step / steps
+		ticks = append(ticks, s.doubleTick(start, at, id, blazego.DoubleStatusWaiting, nil, bets[:placed]))
+	}
+
+	ticks = append(ticks, s.doubleTick(start, rolling, id, blazego.DoubleStatusRolling, nil, bets))
+
+	for _, bet := range bets {
+		if bet.color != color {
+			continue
+		}
+
+		bet.bet.Status = "win"
+		bet.bet.WinAmount = strconv.FormatFloat(roundCents(bet.bet.Amount*color.Payout()), 'f', 2, 64)
+	}
+
+	ticks = append(ticks, s.doubleTick(start, complete, id, blazego.DoubleStatusComplete, &roll, bets))
+
+	s.elapsed = complete + s.options.CompleteDuration
+	return ticks
+}
+
+func (s *DoubleSimulator) placeBets() []*simulatedDoubleBet {
+	if s.options.Players <= 0 {
+		return nil
+	}
+
+	players := int(float64(s.options.Players) * (0.5 + s.random.Float64()))
+	bets := make([]*simulatedDoubleBet, players)
+	for i := range bets {
+		color := blazego.DoubleColorRed
+		switch choice := s.random.Float64(); {
+		case choice < 0.06:
+			color = blazego.DoubleColorWhite
+		case choice < 0.53:
+			color = blazego.DoubleColorBlack
+		}
+
+		amount := randomAmount(s.random, s.options.MeanBet)
+		if color == blazego.DoubleColorWhite {
+			amount = roundCents(amount / 4)
+		}
+
+		bets[i] = &simulatedDoubleBet{
+			bet: blazego.Bet{
+				ID:           randomID(s.random),
+				Amount:       amount,
+				CurrencyType: "BRL",
+				WinAmount:    "0",
+				Status:       "created",
+			},
+			color: color,
+		}
+	}
+	return bets
+}
+
+func (s *DoubleSimulator) doubleTick(start, at time.Duration, id, status string, roll *int, bets []*simulatedDoubleBet) Tick {
+	event := blazego.DoubleTickEvent{
+		ID:        id,
+		CreatedAt: s.options.Start.Add(start).Format(time.RFC3339Nano),
+		UpdatedAt: s.options.Start.Add(at).Format(time.RFC3339Nano),
+		Status:    status,
+		Bets:      make([]blazego.Bet, len(bets)),
+	}
+
+	if roll != nil {
+		color, _ := blazego.DoubleColorFromRoll(*roll)
+		rollValue := blazego.StringOrNumber(strconv.Itoa(*roll))
+		colorValue := blazego.StringOrNumber(strconv.Itoa(int(color)))
+		event.Roll = &rollValue
+		event.Color = &colorValue
+	}
+
+	for i, bet := range bets {
+		event.Bets[i] = bet.bet
+
+		switch bet.color {
+		case blazego.DoubleColorRed:
+			event.TotalRedEurBet += bet.bet.Amount
+			event.TotalRedBetsPlaced++
+		case blazego.DoubleColorBlack:
+			event.TotalBlackEurBet += bet.bet.Amount
+			event.TotalBlackBetsPlaced++
+		case blazego.DoubleColorWhite:
+			event.TotalWhiteEurBet += bet.bet.Amount
+			event.TotalWhiteBetsPlaced++
+		}
+	}
+	event.TotalRedEurBet = roundCents(event.TotalRedEurBet)
+	event.TotalBlackEurBet = roundCents(event.TotalBlackEurBet)
+	event.TotalWhiteEurBet = roundCents(event.TotalWhiteEurBet)
+
+	return Tick{At: at, Event: "double.tick", Payload: event}
+}
+
+// DoubleRounds extrai as rodadas finalizadas dos ticks gerados
+func DoubleRounds(start time.Time, ticks []Tick) []blazego.DoubleRound {
+	rounds := []blazego.DoubleRound{}
+	for _, tick := range ticks {
+		event, ok := tick.Payload.(blazego.DoubleTickEvent)
+		if !ok || event.Status != blazego.DoubleStatusComplete {
+			continue
+		}
+
+		roll, color, err := event.Result()
+		if err != nil {
+			continue
+		}
+
+		rounds = append(rounds, blazego.DoubleRound{
+			ID:          event.ID,
+			Status:      event.Status,
+			Color:       color,
+			Roll:        roll,
+			CreatedAt:   event.CreatedAt,
+			UpdatedAt:   event.UpdatedAt,
+			CompletedAt: start.Add(tick.At),
+		})
+	}
+	return rounds
+}
@@ -0,0 +1,72 @@
+package sim
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/viniciusgdr/blazego"
+)
+
+func TestDoubleSimulatorSeed(t *testing.T) {
+	options := DoubleOptions{Players: 10, Seed: 7}
+	first := NewDoubleSimulator(options).Rounds(50)
+	second := NewDoubleSimulator(options).Rounds(50)
+
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("same seed generated different rounds")
+	}
+
+	other := NewDoubleSimulator(DoubleOptions{Players: 10, Seed: 8}).Rounds(50)
+	if reflect.DeepEqual(first, other) {
+		t.Error("different seeds generated the same rounds")
+	}
+}
+
+func TestDoubleSimulatorRolls(t *testing.T) {
+	const rounds = 30000
+	simulator := NewDoubleSimulator(DoubleOptions{Seed: 1})
+
+	counts := make([]int, 15)
+	for _, round := range DoubleRounds(simulator.options.Start, simulator.Rounds(rounds)) {
+		if round.Roll < 0 || round.Roll > 14 {
+			t.Fatalf("roll = %d", round.Roll)
+		}
+		counts[round.Roll]++
+	}
+
+	// cada número sai em 1/15 das rodadas; 5 desvios padrão de folga
+	expected := float64(rounds) / 15
+	tolerance := 5 * math.Sqrt(expected*(1-1.0/15))
+	for roll, count := range counts {
+		if math.Abs(float64(count)-expected) > tolerance {
+			t.Errorf("roll %d appeared %d times, want %.0f ± %.0f", roll, count, expected, tolerance)
+		}
+	}
+}
+
+func TestDoubleSimulatorPayout(t *testing.T) {
+	simulator := NewDoubleSimulator(DoubleOptions{Players: 50, Seed: 3})
+
+	for _, tick := range simulator.Rounds(20) {
+		event := tick.Payload.(blazego.DoubleTickEvent)
+		if event.Status != blazego.DoubleStatusComplete {
+			continue
+		}
+
+		_, color, err := event.Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, bet := range event.Bets {
+			want := "0"
+			if bet.Status == "win" {
+				want = strconv.FormatFloat(roundCents(bet.Amount*color.Payout()), 'f', 2, 64)
+			}
+			if bet.WinAmount != want {
+				t.Errorf("bet %s on %s won %s, want %s", bet.ID, color, bet.WinAmount, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Package sim gera sequências sintéticas de eventos da Blaze para testes de carga e
+// desenvolvimento de estratégias. As sequências podem ser enviadas pelo servidor do
+// pacote blazetest ou reproduzidas pelo ReplayConnectionSocket.
+package sim
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/blazetest"
+)
+
+// Tick representa um evento gerado pelo simulador
+type Tick struct {
+	At      time.Duration // deslocamento desde o início da simulação
+	Event   string        // nome do evento, por exemplo "crash.tick"
+	Payload interface{}   // blazego.CrashTickEvent, blazego.CrashTickBetsEvent ou blazego.DoubleTickEvent
+}
+
+// Script converte os ticks em eventos do blazetest para a sala do jogo informado
+func Script(game string, ticks []Tick) []blazetest.Event {
+	room, _ := blazego.RoomForGame(game)
+
+	events := make([]blazetest.Event, len(ticks))
+	var previous time.Duration
+	for i, tick := range ticks {
+		events[i] = blazetest.Event{
+			Room:    room,
+			ID:      tick.Event,
+			Payload: tick.Payload,
+			Delay:   tick.At - previous,
+		}
+		previous = tick.At
+	}
+	return events
+}
+
+// Frames converte os ticks em frames gravados para o ReplayConnectionSocket
+func Frames(game string, start time.Time, ticks []Tick) ([]blazego.SessionFrame, error) {
+	room, _ := blazego.RoomForGame(game)
+	connectionID := fmt.Sprintf("sim-%s", game)
+
+	frames := make([]blazego.SessionFrame, len(ticks))
+	for i, tick := range ticks {
+		data, err := json.Marshal(map[string]interface{}{
+			"id":      tick.Event,
+			"payload": tick.Payload,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		frames[i] = blazego.SessionFrame{
+			Time:         start.Add(tick.At),
+			Monotonic:    int64(tick.At),
+			ConnectionID: connectionID,
+			Room:         room,
+			Event:        tick.Event,
+			Data:         fmt.Sprintf(`42["data",%s]`, data),
+		}
+	}
+	return frames, nil
+}
+
+func randomID(random *rand.Rand) string {
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+	id := make([]byte, 10)
+	for i := range id {
+		id[i] = alphabet[random.Intn(len(alphabet))]
+	}
+	return string(id)
+}
+
+// randomAmount gera valores de aposta com distribuição log-normal de média mean
+func randomAmount(random *rand.Rand, mean float64) float64 {
+	const sigma = 1.2
+
+	amount := math.Exp(math.Log(mean) - sigma*sigma/2 + sigma*random.NormFloat64())
+	return math.Max(0.1, roundCents(amount))
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -7,6 +7,7 @@ type SocketOptions struct {
 	Reconnect   *bool                 `json:"reconnect,omitempty"`
 	Options     *ConnectionSocketOpts `json:"options,omitempty"`
 	TimeoutPing *int                  `json:"timeoutPing,omitempty"`
+	Metrics     *Metrics              `json:"-"` // registra as métricas da conexão (opcional)
 }
 
 type GenericSocket[T any] interface {
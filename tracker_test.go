@@ -0,0 +1,171 @@
+package blazego_test
+
+import (
+	"testing"
+
+	"github.com/viniciusgdr/blazego"
+	"github.com/viniciusgdr/blazego/blazetest"
+)
+
+func TestTrackers(t *testing.T) {
+	cashedOut := 2.0
+
+	tests := []struct {
+		name   string
+		game   string
+		script []blazetest.Event
+		// attach registra o tracker na conexão e retorna a verificação feita depois do roteiro
+		attach func(socket blazego.ConnectionSocketResponses) func(t *testing.T)
+	}{
+		{
+			name: "double round tracker",
+			game: "doubles",
+			// d1 nunca termina e é abandonada quando d2 começa
+			script: blazetest.Script(doubleRound("d1", 1)[:2], doubleRound("d2", 0)),
+			attach: func(socket blazego.ConnectionSocketResponses) func(t *testing.T) {
+				tracker := blazego.NewDoubleRoundTracker()
+				tracker.Attach(socket)
+
+				phases := newCollector()
+				for _, event := range []string{"waiting", "rolling", "complete", "abandoned"} {
+					tracker.On(event, func(data interface{}) {
+						round := data.(blazego.DoubleRound)
+						phases.add(event + " " + round.ID)
+					})
+				}
+
+				return func(t *testing.T) {
+					want := []string{"waiting d1", "rolling d1", "abandoned d1", "waiting d2", "rolling d2", "complete d2"}
+					got := phases.wait(t, len(want))
+					for i := range want {
+						if got[i] != want[i] {
+							t.Fatalf("phases = %v, want %v", got, want)
+						}
+					}
+
+					last, ok := tracker.Last()
+					if !ok || last.ID != "d2" || last.Color != blazego.DoubleColorWhite || last.Snapshot == nil {
+						t.Errorf("last = %+v, %v", last, ok)
+					}
+				}
+			},
+		},
+		{
+			name:   "crash history",
+			game:   "crash",
+			script: blazetest.Script(crashRound("crash", "c1", 1), crashRound("crash", "c2", 3), crashRound("crash", "c3", 1.5)),
+			attach: func(socket blazego.ConnectionSocketResponses) func(t *testing.T) {
+				history := blazego.NewCrashHistory("crash", 2)
+				history.Attach(socket)
+
+				// os callbacks rodam em ordem, então o histórico já processou cada tick recebido aqui
+				ticks := newCollector()
+				socket.On("crash.tick", ticks.add)
+
+				return func(t *testing.T) {
+					ticks.wait(t, 9)
+
+					stats := history.Stats()
+					if stats.Count != 2 || stats.Min != 1.5 || stats.Max != 3 || history.StreakUnder(2) != 1 {
+						t.Errorf("stats = %+v, streak under 2 = %d", stats, history.StreakUnder(2))
+					}
+				}
+			},
+		},
+		{
+			name:   "double history",
+			game:   "doubles",
+			script: blazetest.Script(doubleRound("d1", 0), doubleRound("d2", 3), doubleRound("d3", 5), doubleRound("d4", 12)),
+			attach: func(socket blazego.ConnectionSocketResponses) func(t *testing.T) {
+				history := blazego.NewDoubleHistory(3, 2)
+				history.Attach(socket)
+
+				ticks := newCollector()
+				socket.On("double.tick", ticks.add)
+
+				return func(t *testing.T) {
+					ticks.wait(t, 12)
+
+					stats := history.Stats()
+					if stats.Count != 3 || stats.Red != 2 || stats.Black != 1 || stats.White != 0 {
+						t.Errorf("stats = %+v", stats)
+					}
+					// o branco de d1 já saiu do buffer, mas continua contando
+					if stats.SinceWhite != 3 {
+						t.Errorf("since white = %d, want 3", stats.SinceWhite)
+					}
+					if window := history.Window(2); window.Red != 1 || window.Black != 1 {
+						t.Errorf("window = %+v", window)
+					}
+				}
+			},
+		},
+		{
+			name:   "double bets aggregator",
+			game:   "doubles",
+			script: doubleRound("d1", 4),
+			attach: func(socket blazego.ConnectionSocketResponses) func(t *testing.T) {
+				aggregator := blazego.NewDoubleBetsAggregator(10)
+				aggregator.Attach(socket)
+
+				summaries := newCollector()
+				aggregator.On("complete", summaries.add)
+
+				return func(t *testing.T) {
+					summary := summaries.wait(t, 1)[0].(blazego.DoubleBetsSummary)
+					if summary.TotalAmount != 15 || summary.Color != blazego.DoubleColorRed || summary.Payout != 20 || summary.HouseProfit != -5 {
+						t.Errorf("summary = %+v", summary)
+					}
+				}
+			},
+		},
+		{
+			name: "crash bets aggregator",
+			game: "crash_2",
+			script: blazetest.Script(
+				crashRound("crash_2", "c1", 2.5)[:2],
+				blazetest.CrashTickBets("crash_2", 0, blazego.CrashTickBetsEvent{
+					ID:          "c1",
+					TotalEurBet: 30,
+					TotalEurWon: 20,
+					Bets: []blazego.Bet{
+						{ID: "b1", Amount: 10, CashedOutAt: &cashedOut},
+						{ID: "b2", Amount: 20},
+					},
+				}),
+				crashRound("crash_2", "c1", 2.5)[2:],
+			),
+			attach: func(socket blazego.ConnectionSocketResponses) func(t *testing.T) {
+				aggregator := blazego.NewCrashBetsAggregator("crash_2", 1, 10)
+				aggregator.Attach(socket)
+
+				summaries := newCollector()
+				aggregator.On("summary", summaries.add)
+
+				return func(t *testing.T) {
+					summary := summaries.wait(t, 1)[0].(blazego.CrashBetsSummary)
+					if summary.ID != "c1" || summary.CrashPoint != 2.5 || summary.Players != 2 || summary.CashedOut != 1 || summary.HouseProfit != 10 {
+						t.Errorf("summary = %+v", summary)
+					}
+					if len(summary.Whales) != 1 || summary.Whales[0].ID != "b2" {
+						t.Errorf("whales = %+v", summary.Whales)
+					}
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, test.script)
+
+			socket, err := blazego.MakeConnection(testConnection(server, test.game))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer socket.Disconnect()
+
+			test.attach(socket)(t)
+		})
+	}
+}
@@ -86,6 +86,22 @@ type Bet struct {
 	Status       string   `json:"status"` // "win", "created"
 }
 
+// DecodeEvent converte o payload recebido em um callback (map genérico) para o tipo informado
+func DecodeEvent[T any](data interface{}) (T, error) {
+	var event T
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return event, err
+	}
+
+	if err := json.Unmarshal(dataBytes, &event); err != nil {
+		return event, err
+	}
+
+	return event, nil
+}
+
 type Float64String float64
 
 func (f *Float64String) UnmarshalJSON(data []byte) error {
@@ -4,18 +4,26 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"sync"
 
 	"github.com/gorilla/websocket"
+
+	"github.com/viniciusgdr/blazego/internal/emitter"
 )
 
 type NodeConnectionSocket struct {
-	conn      *websocket.Conn
-	callbacks map[string][]func(interface{})
+	// mu protege conn e serializa as escritas, que o gorilla/websocket não permite em paralelo
+	mu           sync.Mutex
+	conn         *websocket.Conn
+	events       *emitter.Emitter
+	recorder     *SessionRecorder
+	room         string
+	connectionID string
 }
 
 func NewNodeConnectionSocket() *NodeConnectionSocket {
 	return &NodeConnectionSocket{
-		callbacks: make(map[string][]func(interface{})),
+		events: emitter.New(),
 	}
 }
 
@@ -51,28 +59,23 @@ func (n *NodeConnectionSocket) Connect(options ConnectionSocketOptions) error {
 		return err
 	}
 
+	n.mu.Lock()
 	n.conn = conn
+	n.connectionID = NewConnectionID()
+	n.mu.Unlock()
 
-	go n.listen()
+	go n.listen(conn)
 
 	n.emit("open", nil)
 
 	return nil
 }
 
-func (n *NodeConnectionSocket) listen() {
-	defer func() {
-		if n.conn != nil {
-			n.conn.Close()
-		}
-	}()
+func (n *NodeConnectionSocket) listen(conn *websocket.Conn) {
+	defer conn.Close()
 
 	for {
-		if n.conn == nil {
-			break
-		}
-
-		_, message, err := n.conn.ReadMessage()
+		_, message, err := conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				n.emit("error", err)
@@ -81,23 +84,43 @@ func (n *NodeConnectionSocket) listen() {
 			break
 		}
 
+		n.record(message)
 		n.emit("message", message)
 	}
 }
 
-func (n *NodeConnectionSocket) On(event string, callback func(data interface{})) {
-	if n.callbacks[event] == nil {
-		n.callbacks[event] = make([]func(interface{}), 0)
+// Record grava todos os frames recebidos a partir da próxima conexão no SessionRecorder
+func (n *NodeConnectionSocket) Record(recorder *SessionRecorder, room string) {
+	n.recorder = recorder
+	n.room = room
+}
+
+func (n *NodeConnectionSocket) record(message []byte) {
+	if n.recorder == nil {
+		return
 	}
-	n.callbacks[event] = append(n.callbacks[event], callback)
+
+	frame := SessionFrame{
+		ConnectionID: n.connectionID,
+		Room:         n.room,
+		Data:         string(message),
+	}
+
+	if eventID, ok := dataFrameEvent(frame.Data); ok {
+		frame.Event = eventID
+	}
+
+	if err := n.recorder.Record(frame); err != nil {
+		n.emit("error", err)
+	}
+}
+
+func (n *NodeConnectionSocket) On(event string, callback func(data interface{})) {
+	n.events.On(event, callback)
 }
 
 func (n *NodeConnectionSocket) emit(event string, data interface{}) {
-	if callbacks, exists := n.callbacks[event]; exists {
-		for _, callback := range callbacks {
-			go callback(data)
-		}
-	}
+	n.events.Emit(event, data)
 }
 
 func (n *NodeConnectionSocket) Emit(event string, data interface{}) {
@@ -105,6 +128,9 @@ func (n *NodeConnectionSocket) Emit(event string, data interface{}) {
 }
 
 func (n *NodeConnectionSocket) Send(data interface{}) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
 	if n.conn == nil {
 		return errors.New("missing socket")
 	}
@@ -123,6 +149,9 @@ func (n *NodeConnectionSocket) Send(data interface{}) error {
 }
 
 func (n *NodeConnectionSocket) Disconnect() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
 	if n.conn == nil {
 		return errors.New("missing socket")
 	}